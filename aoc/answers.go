@@ -0,0 +1,110 @@
+package aoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+)
+
+// AnswersFile is the name of the recorded answers file at the repository root.
+const AnswersFile = "answers.json"
+
+// Answers holds accepted answers keyed by year, then day, then input hash,
+// then part.
+type Answers map[int]map[int]map[string]map[int]string
+
+// LoadAnswers reads the answers file at path. A missing file yields no answers.
+func LoadAnswers(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(Answers), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make(Answers)
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return answers, nil
+}
+
+// Save writes the answers to path.
+func (a Answers) Save(path string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Get returns the accepted answer for a part of a year's day given the
+// input's hash.
+func (a Answers) Get(year, day int, hash string, part int) (string, bool) {
+	answer, ok := a[year][day][hash][part]
+	return answer, ok
+}
+
+// Set records the accepted answer for a part of a year's day given the
+// input's hash.
+func (a Answers) Set(year, day int, hash string, part int, answer string) {
+	if a[year] == nil {
+		a[year] = make(map[int]map[string]map[int]string)
+	}
+	if a[year][day] == nil {
+		a[year][day] = make(map[string]map[int]string)
+	}
+	if a[year][day][hash] == nil {
+		a[year][day][hash] = make(map[int]string)
+	}
+	a[year][day][hash][part] = answer
+}
+
+// Hashes returns the hashes of the inputs answers were recorded for on a
+// year's day, in sorted order.
+func (a Answers) Hashes(year, day int) []string {
+	return slices.Sorted(maps.Keys(a[year][day]))
+}
+
+// HashInput returns the hex encoded SHA-256 of a puzzle input.
+func HashInput(data []byte) string {
+	h := NewInputHasher()
+	h.Write(data)
+	return h.Sum()
+}
+
+// InputHasher hashes a puzzle input as it is written to it, for inputs that
+// are streamed rather than read in full.
+type InputHasher struct {
+	h hash.Hash
+}
+
+func NewInputHasher() *InputHasher {
+	return &InputHasher{h: sha256.New()}
+}
+
+func (ih *InputHasher) Write(p []byte) (int, error) {
+	return ih.h.Write(p)
+}
+
+// Sum returns the hash of everything written so far, the same HashInput
+// returns for it.
+func (ih *InputHasher) Sum() string {
+	return hex.EncodeToString(ih.h.Sum(nil))
+}
+
+// FormatAnswer renders a solver's answer the way it is recorded and submitted.
+func FormatAnswer(answer int) string {
+	return strconv.Itoa(answer)
+}
@@ -0,0 +1,140 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLoadAnswers(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // no file is written if empty
+		want    Answers
+		wantErr bool
+	}{
+		{name: "missing file", want: Answers{}},
+		{name: "empty object", content: "{}", want: Answers{}},
+		{
+			name:    "answers",
+			content: `{"2025": {"5": {"abc": {"1": "42", "2": "7"}}}}`,
+			want:    Answers{2025: {5: {"abc": {1: "42", 2: "7"}}}},
+		},
+		{name: "invalid json", content: "{", wantErr: true},
+		{name: "non-numeric year", content: `{"x": {}}`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), AnswersFile)
+			if test.content != "" {
+				if err := os.WriteFile(path, []byte(test.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := LoadAnswers(path)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("LoadAnswers() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("LoadAnswers() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	a := make(Answers)
+	a.Set(2025, 5, "b", 1, "42")
+	a.Set(2025, 5, "a", 2, "7")
+	a.Set(2025, 5, "a", 1, "6")
+	a.Set(2024, 5, "c", 1, "1")
+	a.Set(2025, 5, "a", 1, "8") // replaces 6
+
+	tests := []struct {
+		year, day int
+		hash      string
+		part      int
+		want      string
+		ok        bool
+	}{
+		{year: 2025, day: 5, hash: "b", part: 1, want: "42", ok: true},
+		{year: 2025, day: 5, hash: "a", part: 1, want: "8", ok: true},
+		{year: 2025, day: 5, hash: "a", part: 2, want: "7", ok: true},
+		{year: 2024, day: 5, hash: "c", part: 1, want: "1", ok: true},
+		{year: 2025, day: 5, hash: "b", part: 2},
+		{year: 2025, day: 5, hash: "c", part: 1},
+		{year: 2025, day: 6, hash: "a", part: 1},
+		{year: 2023, day: 5, hash: "a", part: 1},
+	}
+
+	for _, test := range tests {
+		got, ok := a.Get(test.year, test.day, test.hash, test.part)
+		if got != test.want || ok != test.ok {
+			t.Errorf("Get(%d, %d, %q, %d) = %q, %t, want %q, %t",
+				test.year, test.day, test.hash, test.part, got, ok, test.want, test.ok)
+		}
+	}
+
+	hashes := []struct {
+		year, day int
+		want      []string
+	}{
+		{year: 2025, day: 5, want: []string{"a", "b"}},
+		{year: 2024, day: 5, want: []string{"c"}},
+		{year: 2025, day: 6, want: []string{}},
+	}
+
+	for _, test := range hashes {
+		if got := a.Hashes(test.year, test.day); !slices.Equal(got, test.want) {
+			t.Errorf("Hashes(%d, %d) = %v, want %v", test.year, test.day, got, test.want)
+		}
+	}
+}
+
+func TestAnswersSaveRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), AnswersFile)
+
+	want := make(Answers)
+	want.Set(2025, 5, "abc", 1, "42")
+	want.Set(2025, 12, "def", 1, "-3")
+	want.Set(2024, 1, "abc", 2, "9")
+
+	if err := want.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadAnswers() after Save() = %v, want %v", got, want)
+	}
+}
+
+func TestHashInput(t *testing.T) {
+	// sha256 of "1\n"
+	const want = "4355a46b19d348dc2f57c046f8ef63d4538ebb936000f3c9ee954a27460dd865"
+	if got := HashInput([]byte("1\n")); got != want {
+		t.Errorf("HashInput() = %s, want %s", got, want)
+	}
+
+	h := NewInputHasher()
+	h.Write([]byte("1"))
+	h.Write([]byte("\n"))
+	if got := h.Sum(); got != want {
+		t.Errorf("InputHasher.Sum() = %s, want %s", got, want)
+	}
+}
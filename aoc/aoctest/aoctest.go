@@ -0,0 +1,202 @@
+// Package aoctest checks registered solvers against their examples.
+//
+// Every examples/N.txt of a day is paired with a golden file, examples/N.golden,
+// holding the expected answer of each part the example covers:
+//
+//	# lines starting with # are comments
+//	1: 3
+//	2: 6
+//
+// Parts missing from the golden file are not checked. Running the tests with
+// -update rewrites the golden files with the computed answers.
+//
+// Puzzles whose examples are solved with different parameters than the real
+// input record them in the golden file as "name = value" lines, which are
+// passed to solvers that are aoc.Configurable:
+//
+//	connections = 10
+//	1: 40
+package aoctest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+var update = flag.Bool("update", false, "rewrite the example golden files with the computed answers")
+
+// Run solves every example of the registered day of aoc.DefaultYear as a
+// subtest and compares the answers against the example's golden file.
+func Run(t *testing.T, day int) {
+	t.Helper()
+	RunYear(t, aoc.DefaultYear, day)
+}
+
+// RunYear is like Run for a day of any year.
+func RunYear(t *testing.T, year, day int) {
+	t.Helper()
+
+	d, ok := aoc.Lookup(year, day)
+	if !ok {
+		t.Fatalf("%d day %d is not registered", year, day)
+	}
+
+	names, err := fs.Glob(d.Examples, "examples/*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(names) == 0 {
+		t.Fatalf("day %d has no examples", day)
+	}
+
+	for _, name := range names {
+		id := strings.TrimSuffix(path.Base(name), ".txt")
+		t.Run("example_"+id, func(t *testing.T) {
+			runExample(t, d, id)
+		})
+	}
+}
+
+func runExample(t *testing.T, d aoc.Day, id string) {
+	input, err := fs.ReadFile(d.Examples, "examples/"+id+".txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goldenPath := "examples/" + id + ".golden"
+	golden, err := readGolden(d.Examples, goldenPath)
+	if errors.Is(err, fs.ErrNotExist) && *update {
+		golden = &goldenFile{answers: make(map[int]string)}
+	} else if errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("missing %s, run the tests with -update to create it", goldenPath)
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	// a new golden file records every part, an existing one only the parts
+	// it already covers
+	recordAll := len(golden.answers) == 0
+
+	solver := d.New()
+	if err := aoc.Configure(solver, aoc.ExamplePrefix+id, d.Examples); err != nil {
+		t.Fatalf("configure: %v", err)
+	}
+	if err := solver.Parse(bytes.NewReader(input)); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	for part, solve := range []func(context.Context) (int, error){solver.PartOne, solver.PartTwo} {
+		part++
+
+		want, ok := golden.answers[part]
+		if !ok && !(*update && recordAll) {
+			continue
+		}
+
+		answer, err := solve(t.Context())
+		if errors.Is(err, aoc.ErrNoPart) {
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("part %d: %v", part, err)
+			continue
+		}
+
+		got := aoc.FormatAnswer(answer)
+		if *update {
+			golden.answers[part] = got
+			continue
+		}
+
+		if got != want {
+			t.Errorf("part %d = %s, want %s", part, got, want)
+		}
+	}
+
+	if *update {
+		if err := golden.write(filepath.FromSlash(goldenPath)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+type goldenFile struct {
+	comments []string
+	settings []string // "name = value" lines, see aoc.ExampleSettings
+	answers  map[int]string
+}
+
+func readGolden(fsys fs.FS, name string) (*goldenFile, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	golden := &goldenFile{answers: make(map[int]string)}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			golden.comments = append(golden.comments, line)
+			continue
+		}
+
+		if strings.Contains(line, "=") {
+			golden.settings = append(golden.settings, line)
+			continue
+		}
+
+		rawPart, answer, ok := strings.Cut(line, ":")
+		part, err := strconv.Atoi(rawPart)
+		if !ok || err != nil || (part != 1 && part != 2) {
+			return nil, fmt.Errorf("%s:%d: expected \"<part>: <answer>\", got %q", name, i, line)
+		}
+
+		golden.answers[part] = strings.TrimSpace(answer)
+	}
+
+	return golden, scanner.Err()
+}
+
+func (g *goldenFile) write(name string) error {
+	var buf bytes.Buffer
+	for _, comment := range g.comments {
+		fmt.Fprintln(&buf, comment)
+	}
+	for _, setting := range g.settings {
+		fmt.Fprintln(&buf, setting)
+	}
+
+	parts := make([]int, 0, len(g.answers))
+	for part := range g.answers {
+		parts = append(parts, part)
+	}
+	slices.Sort(parts)
+
+	for _, part := range parts {
+		fmt.Fprintf(&buf, "%d: %s\n", part, g.answers[part])
+	}
+
+	return os.WriteFile(name, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,105 @@
+package aoctest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+const (
+	// fuzzSolveTime is how long the parts get to solve an input the parser
+	// accepted
+	fuzzSolveTime = 100 * time.Millisecond
+
+	// fuzzStopTime is how long the parts get to stop once their context is
+	// done before the input fails as a hang
+	fuzzStopTime = time.Second
+)
+
+// Fuzz feeds arbitrary inputs to the parser of a day of aoc.DefaultYear,
+// which must reject anything it can't parse with an error rather than a
+// panic. Inputs it accepts are solved for a moment, so the parser must also
+// reject anything the parts would crash on or can't finish: parts that are
+// still running a moment after their context is done fail the input as a
+// hang. The day's examples and a few small generated inputs seed the corpus
+// alongside the regression inputs under testdata/fuzz.
+func Fuzz(f *testing.F, day int) {
+	f.Helper()
+	FuzzYear(f, aoc.DefaultYear, day)
+}
+
+// FuzzYear is like Fuzz for a day of any year.
+func FuzzYear(f *testing.F, year, day int) {
+	f.Helper()
+
+	d, ok := aoc.Lookup(year, day)
+	if !ok {
+		f.Fatalf("%d day %d is not registered", year, day)
+	}
+
+	names, err := fs.Glob(d.Examples, "examples/*.txt")
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	for _, name := range names {
+		input, err := fs.ReadFile(d.Examples, name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(input)
+	}
+
+	if gen, ok := aoc.LookupGenerator(year, day); ok {
+		for seed := range uint64(3) {
+			var buf bytes.Buffer
+			if err := gen(&buf, rand.New(rand.NewPCG(seed, seed)), 3); err != nil {
+				f.Fatal(err)
+			}
+			f.Add(buf.Bytes())
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		// errors are expected, only panics and hangs fail
+		solver := d.New()
+		if solver.Parse(bytes.NewReader(input)) != nil {
+			return
+		}
+
+		// parts that run past the deadline are fine as long as they stop
+		ctx, cancel := context.WithTimeout(t.Context(), fuzzSolveTime)
+		defer cancel()
+
+		// a panic on another goroutine would take down the fuzzer without
+		// saying which input caused it, so it is reported from this one
+		panicked := make(chan string, 1)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					panicked <- fmt.Sprintf("%v\n%s", r, debug.Stack())
+				}
+				close(panicked)
+			}()
+
+			solver.PartOne(ctx)
+			solver.PartTwo(ctx)
+		}()
+
+		select {
+		case msg, ok := <-panicked:
+			if ok {
+				t.Fatalf("panic: %s", msg)
+			}
+		case <-time.After(fuzzSolveTime + fuzzStopTime):
+			t.Fatalf("parts still running %s after their deadline", fuzzStopTime)
+		}
+	})
+}
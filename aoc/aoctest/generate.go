@@ -0,0 +1,62 @@
+package aoctest
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// generateSizes are the sizes every seed is generated at, from the smallest
+// input up to one about as large as a real input
+var generateSizes = []int{1, 2, 10, 100}
+
+// Generate checks that the input generator of a day of aoc.DefaultYear is
+// deterministic and that the day's solver parses what it writes, for a few
+// seeds and sizes.
+func Generate(t *testing.T, day int) {
+	t.Helper()
+	GenerateYear(t, aoc.DefaultYear, day)
+}
+
+// GenerateYear is like Generate for a day of any year.
+func GenerateYear(t *testing.T, year, day int) {
+	t.Helper()
+
+	d, ok := aoc.Lookup(year, day)
+	if !ok {
+		t.Fatalf("%d day %d is not registered", year, day)
+	}
+
+	gen, ok := aoc.LookupGenerator(year, day)
+	if !ok {
+		t.Fatalf("%d day %d has no input generator", year, day)
+	}
+
+	for seed := range uint64(5) {
+		for _, size := range generateSizes {
+			t.Run(fmt.Sprintf("seed_%d_size_%d", seed, size), func(t *testing.T) {
+				input := generate(t, gen, seed, size)
+				if again := generate(t, gen, seed, size); !bytes.Equal(input, again) {
+					t.Fatal("generator wrote a different input for the same seed")
+				}
+
+				if err := d.New().Parse(bytes.NewReader(input)); err != nil {
+					t.Fatalf("parse: %v\ninput:\n%s", err, input)
+				}
+			})
+		}
+	}
+}
+
+func generate(t *testing.T, gen aoc.Generator, seed uint64, size int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := gen(&buf, rand.New(rand.NewPCG(seed, seed)), size); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
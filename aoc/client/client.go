@@ -0,0 +1,142 @@
+// Package client talks to the Advent of Code website.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// DefaultUserAgent identifies the tool to the Advent of Code maintainers
+	// as they ask of automated clients
+	DefaultUserAgent = "github.com/ayo-awe/advent-of-code-2025 aoc command"
+)
+
+var (
+	// ErrUnauthorized is returned when the session cookie is missing, invalid
+	// or expired and the site serves its login page instead
+	ErrUnauthorized = errors.New("not logged in, check your session cookie")
+
+	// ErrLocked is returned when the puzzle hasn't unlocked yet
+	ErrLocked = errors.New("puzzle has not unlocked yet")
+)
+
+// Client makes authenticated requests to the Advent of Code website.
+type Client struct {
+	BaseURL    string
+	Session    string // value of the session cookie
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+// New returns a client for the live site authenticated with session.
+func New(session string) *Client {
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		Session:    session,
+		UserAgent:  DefaultUserAgent,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Input downloads the puzzle input for a day.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	body, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, errors.New("downloaded input is empty")
+	}
+
+	if isHTML(body) {
+		return nil, ErrUnauthorized
+	}
+
+	return body, nil
+}
+
+// Puzzle downloads the HTML page describing a day's puzzle. Part two only
+// appears on the page once the session's user has solved part one.
+func (c *Client) Puzzle(ctx context.Context, year, day int) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("/%d/day/%d", year, day))
+}
+
+// Leaderboard downloads the JSON of a private leaderboard. The site asks that
+// it isn't requested more than once every 15 minutes.
+func (c *Client) Leaderboard(ctx context.Context, year, id int) ([]byte, error) {
+	body, err := c.get(ctx, fmt.Sprintf("/%d/leaderboard/private/view/%d.json", year, id))
+	if err != nil {
+		return nil, err
+	}
+
+	// leaderboards the user can't see redirect to an HTML page
+	if isHTML(body) {
+		return nil, ErrUnauthorized
+	}
+
+	return body, nil
+}
+
+// get fetches path relative to the base URL and returns the response body of
+// a successful request
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.BaseURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req)
+}
+
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrUnauthorized
+	}
+
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, nil
+	case resp.StatusCode == http.StatusBadRequest, resp.StatusCode == http.StatusUnauthorized:
+		// the site answers requests without a valid session with a 400
+		return nil, ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrLocked
+	default:
+		return nil, fmt.Errorf("%s %s: unexpected status %s", req.Method, req.URL.Path, resp.Status)
+	}
+}
+
+// isHTML reports whether body looks like an HTML page rather than plain text
+func isHTML(body []byte) bool {
+	trimmed := bytes.ToLower(bytes.TrimSpace(body))
+	return bytes.HasPrefix(trimmed, []byte("<!doctype html")) || bytes.HasPrefix(trimmed, []byte("<html"))
+}
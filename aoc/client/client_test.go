@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c := New("secret")
+	c.BaseURL = srv.URL
+	c.HTTPClient = srv.Client()
+	return c
+}
+
+func TestInput(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/3/input" {
+			t.Errorf("path = %s, want /2025/day/3/input", r.URL.Path)
+		}
+
+		if ua := r.Header.Get("User-Agent"); ua != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", ua, DefaultUserAgent)
+		}
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v, want secret", cookie, err)
+		}
+
+		w.Write([]byte("123\n456\n"))
+	})
+
+	input, err := c.Input(context.Background(), 2025, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(input) != "123\n456\n" {
+		t.Errorf("input = %q, want %q", input, "123\n456\n")
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{name: "login page", status: http.StatusOK, body: "<!DOCTYPE html>\n<html><body>Log in</body></html>", wantErr: ErrUnauthorized},
+		{name: "bad session", status: http.StatusBadRequest, body: "Puzzle inputs differ by user.  Please log in to get your puzzle input.", wantErr: ErrUnauthorized},
+		{name: "locked", status: http.StatusNotFound, body: "Please don't repeatedly request this endpoint before it unlocks!", wantErr: ErrLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			if _, err := c.Input(context.Background(), 2025, 1); !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	tests := []struct {
+		name    string
+		article string
+		want    Verdict
+	}{
+		{
+			name:    "correct",
+			article: `<p>That's the right answer!  You are <span class="day-success">one gold star</span> closer to decorating the North Pole.</p>`,
+			want:    Verdict{Outcome: Correct},
+		},
+		{
+			name:    "too high",
+			article: `<p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. [<a href="/2025/day/1">Return to Day 1</a>]</p>`,
+			want:    Verdict{Outcome: TooHigh, Wait: time.Minute},
+		},
+		{
+			name:    "too low",
+			article: `<p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.</p>`,
+			want:    Verdict{Outcome: TooLow, Wait: 5 * time.Minute},
+		},
+		{
+			name:    "incorrect",
+			article: `<p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p>`,
+			want:    Verdict{Outcome: Incorrect},
+		},
+		{
+			name:    "rate limited",
+			article: `<p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait.</p>`,
+			want:    Verdict{Outcome: RateLimited, Wait: time.Minute + 23*time.Second},
+		},
+		{
+			name:    "already solved",
+			article: `<p>You don't seem to be solving the right level.  Did you already complete it?</p>`,
+			want:    Verdict{Outcome: AlreadySolved},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/2025/day/1/answer" {
+					t.Errorf("got %s %s, want POST /2025/day/1/answer", r.Method, r.URL.Path)
+				}
+
+				if level, answer := r.FormValue("level"), r.FormValue("answer"); level != "2" || answer != "42" {
+					t.Errorf("level, answer = %q, %q, want 2, 42", level, answer)
+				}
+
+				w.Write([]byte("<html><body><main><article>" + tt.article + "</article></main></body></html>"))
+			})
+
+			got, err := c.Submit(context.Background(), 2025, 1, 2, "42")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.Outcome != tt.want.Outcome || got.Wait != tt.want.Wait {
+				t.Errorf("verdict = %v after %v, want %v after %v", got.Outcome, got.Wait, tt.want.Outcome, tt.want.Wait)
+			}
+		})
+	}
+}
+
+func TestPuzzle(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/7" {
+			t.Errorf("path = %s, want /2025/day/7", r.URL.Path)
+		}
+		w.Write([]byte("<article class=\"day-desc\"></article>"))
+	})
+
+	page, err := c.Puzzle(context.Background(), 2025, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(page) != "<article class=\"day-desc\"></article>" {
+		t.Errorf("page = %q", page)
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/leaderboard/private/view/42.json" {
+			t.Errorf("path = %s, want /2025/leaderboard/private/view/42.json", r.URL.Path)
+		}
+		w.Write([]byte(`{"event":"2025","members":{}}`))
+	})
+
+	if _, err := c.Leaderboard(context.Background(), 2025, 42); err != nil {
+		t.Fatal(err)
+	}
+
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<!DOCTYPE html>\n<html><body>[Log In]</body></html>"))
+	})
+
+	if _, err := c.Leaderboard(context.Background(), 2025, 42); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("err = %v, want %v", err, ErrUnauthorized)
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's verdict on a submitted answer.
+type Outcome string
+
+const (
+	Unknown       Outcome = "unknown"
+	Correct       Outcome = "correct"
+	Incorrect     Outcome = "incorrect"
+	TooHigh       Outcome = "too high"
+	TooLow        Outcome = "too low"
+	RateLimited   Outcome = "rate limited"
+	AlreadySolved Outcome = "already solved"
+)
+
+// Verdict is the parsed response to a submitted answer.
+type Verdict struct {
+	Outcome Outcome
+	// Wait is how long the site asks to wait before submitting again
+	Wait time.Duration
+	// Message is the text of the response page
+	Message string
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+
+	// e.g "You have 1m 23s left to wait."
+	leftToWaitPattern = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	// e.g "please wait 5 minutes before trying again"
+	waitMinutesPattern = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// Submit posts the answer to a part of a day's puzzle and returns the verdict.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Verdict, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	endpoint := fmt.Sprintf("%s/%d/day/%d/answer", strings.TrimSuffix(c.BaseURL, "/"), year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	match := articlePattern.FindSubmatch(body)
+	if match == nil {
+		// only logged in users get a verdict
+		return Verdict{}, ErrUnauthorized
+	}
+
+	return ParseVerdict(string(match[1])), nil
+}
+
+// ParseVerdict interprets the article of the page returned for a submission.
+func ParseVerdict(article string) Verdict {
+	text := html.UnescapeString(tagPattern.ReplaceAllString(article, ""))
+	text = strings.Join(strings.Fields(text), " ")
+
+	v := Verdict{Outcome: Unknown, Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		v.Outcome = Correct
+	case strings.Contains(text, "You gave an answer too recently"):
+		v.Outcome = RateLimited
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		v.Outcome = AlreadySolved
+	case strings.Contains(text, "That's not the right answer") && strings.Contains(text, "too high"):
+		v.Outcome = TooHigh
+	case strings.Contains(text, "That's not the right answer") && strings.Contains(text, "too low"):
+		v.Outcome = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		v.Outcome = Incorrect
+	}
+
+	if m := leftToWaitPattern.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		v.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := waitMinutesPattern.FindStringSubmatch(text); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		v.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return v
+}
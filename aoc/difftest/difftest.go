@@ -0,0 +1,115 @@
+// Package difftest checks optimised implementations against slow reference
+// implementations that are easier to trust.
+//
+// A Check generates random inputs, runs both implementations on each and
+// fails on the first input they disagree on. Before failing, the input is
+// shrunk to the smallest variant that still shows the disagreement so the
+// counterexample is easy to follow by hand.
+//
+// Inputs are generated from a fixed seed so failures reproduce. Run the tests
+// with -difftest.seed to explore other inputs and -difftest.n to change how
+// many are tried.
+package difftest
+
+import (
+	"flag"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+var (
+	numInputs = flag.Int("difftest.n", 2000, "number of inputs every differential check tries, a tenth of it with -short")
+	seed      = flag.Uint64("difftest.seed", 1, "seed of the inputs of the differential checks")
+)
+
+// Check compares an optimised implementation against a reference one.
+type Check[In any] struct {
+	// Generate returns a random input, size scales it from 1 to MaxSize
+	Generate func(rng *rand.Rand, size int) In
+	MaxSize  int
+
+	// Valid reports whether the optimised implementation is meant to handle
+	// an input, inputs it rejects are skipped. Every input is valid if it is
+	// nil.
+	Valid func(in In) bool
+
+	Reference func(in In) int
+	Optimised func(in In) int
+
+	// Shrink returns smaller variants of an input, which need not be valid.
+	// Inputs are reported as generated if it is nil.
+	Shrink func(in In) iter.Seq[In]
+
+	// Format renders an input for a failure message, with %v if it is nil
+	Format func(in In) string
+}
+
+// Run generates inputs for c and fails the test with a shrunk counterexample
+// on the first one whose answers differ.
+func Run[In any](t *testing.T, c Check[In]) {
+	t.Helper()
+
+	n := *numInputs
+	if testing.Short() {
+		n = max(n/10, 1)
+	}
+
+	var skipped int
+	for i := range n {
+		size := 1 + i%max(c.MaxSize, 1)
+		in := c.Generate(rand.New(rand.NewPCG(*seed, uint64(i))), size)
+		if c.Valid != nil && !c.Valid(in) {
+			skipped++
+			continue
+		}
+
+		if c.diverges(in) {
+			small, steps := c.shrink(in)
+			t.Fatalf("input %d (seed %d, size %d) diverges, shrunk in %d steps to:\n%s\noptimised = %d, reference = %d",
+				i, *seed, size, steps, c.format(small), c.Optimised(small), c.Reference(small))
+		}
+	}
+
+	if skipped == n {
+		t.Fatalf("all %d generated inputs were invalid", n)
+	}
+	t.Logf("%d inputs agreed, %d invalid inputs skipped", n-skipped, skipped)
+}
+
+func (c Check[In]) diverges(in In) bool {
+	return c.Optimised(in) != c.Reference(in)
+}
+
+// shrink greedily replaces in with the first smaller variant that is valid
+// and still diverges until no variant does
+func (c Check[In]) shrink(in In) (In, int) {
+	if c.Shrink == nil {
+		return in, 0
+	}
+
+	var steps int
+	for shrunk := true; shrunk; {
+		shrunk = false
+		for smaller := range c.Shrink(in) {
+			if c.Valid != nil && !c.Valid(smaller) {
+				continue
+			}
+			if c.diverges(smaller) {
+				in, shrunk = smaller, true
+				steps++
+				break
+			}
+		}
+	}
+	return in, steps
+}
+
+func (c Check[In]) format(in In) string {
+	if c.Format == nil {
+		return fmt.Sprintf("%v", in)
+	}
+	return strings.TrimSuffix(c.Format(in), "\n")
+}
@@ -0,0 +1,83 @@
+package difftest
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func sum(nums []int) int {
+	var total int
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// removals yields nums without each of its numbers and with each of them
+// one smaller
+func removals(nums []int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		for i := range nums {
+			if !yield(slices.Delete(slices.Clone(nums), i, i+1)) {
+				return
+			}
+		}
+		for i := range nums {
+			smaller := slices.Clone(nums)
+			smaller[i]--
+			if !yield(smaller) {
+				return
+			}
+		}
+	}
+}
+
+func randomNums(rng *rand.Rand, size int) []int {
+	nums := make([]int, size)
+	for i := range nums {
+		nums[i] = rng.IntN(100)
+	}
+	return nums
+}
+
+func TestRun(t *testing.T) {
+	Run(t, Check[[]int]{
+		Generate:  randomNums,
+		MaxSize:   10,
+		Reference: sum,
+		Optimised: func(nums []int) int { return sum(slices.Clone(nums)) },
+	})
+}
+
+func TestShrink(t *testing.T) {
+	c := Check[[]int]{
+		Valid:     func(nums []int) bool { return !slices.ContainsFunc(nums, func(n int) bool { return n < 0 }) },
+		Reference: sum,
+		// forgets numbers over 50
+		Optimised: func(nums []int) int {
+			var total int
+			for _, n := range nums {
+				if n <= 50 {
+					total += n
+				}
+			}
+			return total
+		},
+		Shrink: removals,
+	}
+
+	in := []int{3, 70, 12, 99, 0, 64}
+	if !c.diverges(in) {
+		t.Fatal("expected the input to diverge")
+	}
+
+	got, steps := c.shrink(in)
+	if want := []int{51}; !slices.Equal(got, want) {
+		t.Errorf("shrink = %v, want %v", got, want)
+	}
+	if steps == 0 {
+		t.Error("shrink took no steps")
+	}
+}
@@ -0,0 +1,91 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
+)
+
+// ParseError reports malformed puzzle input along with its position.
+type ParseError struct {
+	File string // input name, empty if unknown
+	Line int    // 1-based line number
+	// Column is the 1-based byte offset of the offending character within
+	// Text, 0 if the whole line is at fault
+	Column int
+	Text   string // the offending line
+	Err    error
+}
+
+// NewParseError returns a ParseError for the 1-based line holding text. The
+// column is taken from err when it is a *parse.Error.
+func NewParseError(line int, text string, err error) *ParseError {
+	perr := &ParseError{Line: line, Text: text, Err: err}
+
+	var patternErr *parse.Error
+	if errors.As(err, &patternErr) {
+		perr.Column = patternErr.Offset + 1
+		perr.Err = patternErr.Err
+	}
+
+	return perr
+}
+
+func (e *ParseError) Error() string {
+	var sb strings.Builder
+
+	switch {
+	case e.File != "" && e.Column > 0:
+		fmt.Fprintf(&sb, "%s:%d:%d: ", e.File, e.Line, e.Column)
+	case e.File != "":
+		fmt.Fprintf(&sb, "%s:%d: ", e.File, e.Line)
+	case e.Column > 0:
+		fmt.Fprintf(&sb, "line %d, column %d: ", e.Line, e.Column)
+	default:
+		fmt.Fprintf(&sb, "line %d: ", e.Line)
+	}
+	sb.WriteString(e.Err.Error())
+
+	if e.Text == "" && e.Column == 0 {
+		return sb.String()
+	}
+
+	// render the line with a caret under the offending character
+	fmt.Fprintf(&sb, "\n\t%s", e.Text)
+	if e.Column > 0 {
+		prefix := e.Text[:min(e.Column-1, len(e.Text))]
+		fmt.Fprintf(&sb, "\n\t%s^", caretPadding(prefix))
+	}
+
+	return sb.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// caretPadding returns whitespace as wide as prefix, keeping tabs so the caret
+// stays aligned
+func caretPadding(prefix string) string {
+	var sb strings.Builder
+	for _, r := range prefix {
+		if r == '\t' {
+			sb.WriteRune('\t')
+		} else {
+			sb.WriteRune(' ')
+		}
+	}
+	return sb.String()
+}
+
+// WithFile records name as the input file of the ParseError in err's chain,
+// if any, and returns err.
+func WithFile(err error, name string) error {
+	var perr *ParseError
+	if errors.As(err, &perr) && perr.File == "" {
+		perr.File = name
+	}
+	return err
+}
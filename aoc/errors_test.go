@@ -0,0 +1,124 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
+)
+
+func TestParseErrorString(t *testing.T) {
+	errBad := errors.New("bad input")
+
+	tests := []struct {
+		name string
+		err  *ParseError
+		want string
+	}{
+		{
+			name: "line only",
+			err:  &ParseError{Line: 3, Err: errBad},
+			want: "line 3: bad input",
+		},
+		{
+			name: "whole line",
+			err:  &ParseError{Line: 3, Text: "L68", Err: errBad},
+			want: "line 3: bad input\n\tL68",
+		},
+		{
+			name: "column",
+			err:  &ParseError{Line: 3, Column: 2, Text: "L6x", Err: errBad},
+			want: "line 3, column 2: bad input\n\tL6x\n\t ^",
+		},
+		{
+			name: "first column",
+			err:  &ParseError{Line: 1, Column: 1, Text: "x", Err: errBad},
+			want: "line 1, column 1: bad input\n\tx\n\t^",
+		},
+		{
+			name: "past the end",
+			err:  &ParseError{Line: 1, Column: 4, Text: "abc", Err: errBad},
+			want: "line 1, column 4: bad input\n\tabc\n\t   ^",
+		},
+		{
+			name: "column beyond the text",
+			err:  &ParseError{Line: 1, Column: 9, Text: "abc", Err: errBad},
+			want: "line 1, column 9: bad input\n\tabc\n\t   ^",
+		},
+		{
+			name: "file",
+			err:  &ParseError{File: "input.txt", Line: 3, Text: "L68", Err: errBad},
+			want: "input.txt:3: bad input\n\tL68",
+		},
+		{
+			name: "file and column",
+			err:  &ParseError{File: "input.txt", Line: 3, Column: 3, Text: "L68", Err: errBad},
+			want: "input.txt:3:3: bad input\n\tL68\n\t  ^",
+		},
+		{
+			name: "tabs",
+			err:  &ParseError{Line: 1, Column: 4, Text: "\ta\tb", Err: errBad},
+			want: "line 1, column 4: bad input\n\t\ta\tb\n\t\t \t^",
+		},
+		{
+			// columns are bytes but the caret moves one space per rune
+			name: "multi-byte",
+			err:  &ParseError{Line: 1, Column: 6, Text: "éé x", Err: errBad},
+			want: "line 1, column 6: bad input\n\téé x\n\t   ^",
+		},
+		{
+			name: "three byte runes",
+			err:  &ParseError{Line: 1, Column: 8, Text: "→→ x", Err: errBad},
+			want: "line 1, column 8: bad input\n\t→→ x\n\t   ^",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.err.Error(); got != test.want {
+				t.Errorf("Error() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewParseError(t *testing.T) {
+	var x int
+	patternErr := parse.MustCompile("{int},{int}", nil).Scan("12,x", &x, &x)
+
+	perr := NewParseError(7, "12,x", patternErr)
+	if perr.Line != 7 || perr.Column != 4 || perr.Text != "12,x" {
+		t.Errorf("NewParseError() at %d:%d %q, want 7:4 %q", perr.Line, perr.Column, perr.Text, "12,x")
+	}
+
+	// the column moves out of the message and under the caret
+	want := "line 7, column 4: invalid {int}: strconv.Atoi: parsing \"x\": invalid syntax\n\t12,x\n\t   ^"
+	if got := perr.Error(); got != want {
+		t.Errorf("Error() =\n%s\nwant\n%s", got, want)
+	}
+
+	plain := NewParseError(2, "abc", fs.ErrInvalid)
+	if plain.Column != 0 || !errors.Is(plain, fs.ErrInvalid) {
+		t.Errorf("NewParseError() of a plain error = %#v, want no column wrapping fs.ErrInvalid", plain)
+	}
+}
+
+func TestWithFile(t *testing.T) {
+	perr := &ParseError{Line: 1, Err: fs.ErrInvalid}
+	err := WithFile(fmt.Errorf("parse: %w", perr), "day_01/input.txt")
+	if perr.File != "day_01/input.txt" {
+		t.Errorf("File = %q, want day_01/input.txt", perr.File)
+	}
+
+	// an inner name is more precise and is kept
+	WithFile(err, "other.txt")
+	if perr.File != "day_01/input.txt" {
+		t.Errorf("File = %q after a second WithFile, want day_01/input.txt", perr.File)
+	}
+
+	if plain := WithFile(fs.ErrInvalid, "input.txt"); plain != fs.ErrInvalid {
+		t.Errorf("WithFile() = %v, want the error unchanged", plain)
+	}
+}
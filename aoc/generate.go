@@ -0,0 +1,37 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+)
+
+// Generator writes a random input for a day's puzzle. The input depends only
+// on the state of rng and on size, which scales the input, usually as the
+// number of lines or items it holds. Every input a generator writes must be
+// accepted by the day's solver.
+type Generator func(w io.Writer, rng *rand.Rand, size int) error
+
+var generators = make(map[dayKey]Generator)
+
+// RegisterGenerator adds the input generator of a day of DefaultYear. Like
+// Register it is meant to be called from an init function and panics if the
+// day already has a generator.
+func RegisterGenerator(day int, gen Generator) {
+	RegisterGeneratorYear(DefaultYear, day, gen)
+}
+
+// RegisterGeneratorYear is like RegisterGenerator for a day of any year.
+func RegisterGeneratorYear(year, day int, gen Generator) {
+	key := dayKey{year: year, day: day}
+	if _, exists := generators[key]; exists {
+		panic(fmt.Sprintf("aoc: %d day %d generator registered twice", year, day))
+	}
+	generators[key] = gen
+}
+
+// LookupGenerator returns the input generator of a day.
+func LookupGenerator(year, day int) (Generator, bool) {
+	gen, ok := generators[dayKey{year: year, day: day}]
+	return gen, ok
+}
@@ -0,0 +1,187 @@
+package aoc
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Point is a position on a Grid. X grows to the right and Y grows downwards.
+type Point struct {
+	X, Y int
+}
+
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+var (
+	Up    = Point{X: 0, Y: -1}
+	Down  = Point{X: 0, Y: 1}
+	Left  = Point{X: -1, Y: 0}
+	Right = Point{X: 1, Y: 0}
+
+	// Cardinals are the offsets to the 4 orthogonal neighbours of a point
+	Cardinals = []Point{Up, Right, Down, Left}
+
+	// Compass are the offsets to all 8 neighbours of a point, diagonals included
+	Compass = []Point{
+		Up, Up.Add(Right), Right, Down.Add(Right),
+		Down, Down.Add(Left), Left, Up.Add(Left),
+	}
+)
+
+// Grid is a fixed size, row-major 2D grid.
+type Grid[T any] struct {
+	Width, Height int
+	cells         []T
+}
+
+// NewGrid returns a width x height grid with every cell set to fill.
+func NewGrid[T any](width, height int, fill T) *Grid[T] {
+	cells := make([]T, width*height)
+	for i := range cells {
+		cells[i] = fill
+	}
+	return &Grid[T]{Width: width, Height: height, cells: cells}
+}
+
+// ParseGrid builds a grid from lines, converting every rune with conv. All
+// lines must have the same number of runes.
+func ParseGrid[T any](lines []string, conv func(rune) T) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return &Grid[T]{}, nil
+	}
+
+	width := len([]rune(lines[0]))
+	cells := make([]T, 0, width*len(lines))
+	for y, line := range lines {
+		row := []rune(line)
+		if len(row) != width {
+			return nil, &ParseError{
+				Line:   y + 1,
+				Column: len(string(row[:min(len(row), width)])) + 1,
+				Text:   line,
+				Err:    fmt.Errorf("line has %d columns, expected %d", len(row), width),
+			}
+		}
+
+		for _, r := range row {
+			cells = append(cells, conv(r))
+		}
+	}
+
+	return &Grid[T]{Width: width, Height: len(lines), cells: cells}, nil
+}
+
+// ParseRuneGrid builds a grid of the runes in lines.
+func ParseRuneGrid(lines []string) (*Grid[rune], error) {
+	return ParseGrid(lines, func(r rune) rune { return r })
+}
+
+// In reports whether p lies within the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.Width && p.Y >= 0 && p.Y < g.Height
+}
+
+// At returns the value at p. It panics if p is out of bounds.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Get returns the value at p and whether p is within the grid.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[g.index(p)], true
+}
+
+// Set updates the value at p. It panics if p is out of bounds.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[g.index(p)] = v
+}
+
+func (g *Grid[T]) index(p Point) int {
+	if !g.In(p) {
+		panic(fmt.Sprintf("aoc: point %v out of bounds for %dx%d grid", p, g.Width, g.Height))
+	}
+	return p.Y*g.Width + p.X
+}
+
+// All iterates over every cell in row-major order.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{X: i % g.Width, Y: i / g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 iterates over the orthogonal neighbours of p that are in bounds.
+func (g *Grid[T]) Neighbours4(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Cardinals)
+}
+
+// Neighbours8 iterates over all neighbours of p, diagonals included, that are
+// in bounds.
+func (g *Grid[T]) Neighbours8(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Compass)
+}
+
+func (g *Grid[T]) neighbours(p Point, offsets []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, offset := range offsets {
+			n := p.Add(offset)
+			if !g.In(n) {
+				continue
+			}
+
+			if !yield(n, g.cells[g.index(n)]) {
+				return
+			}
+		}
+	}
+}
+
+// FindAll returns the points of every cell matching pred in row-major order.
+func (g *Grid[T]) FindAll(pred func(T) bool) []Point {
+	var points []Point
+	for p, v := range g.All() {
+		if pred(v) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Clone returns a copy of the grid that shares no cells with the original.
+func (g *Grid[T]) Clone() *Grid[T] {
+	cells := make([]T, len(g.cells))
+	copy(cells, g.cells)
+	return &Grid[T]{Width: g.Width, Height: g.Height, cells: cells}
+}
+
+// String renders the grid one row per line. Runes and bytes are written as
+// characters and every other value is formatted with fmt.
+func (g *Grid[T]) String() string {
+	var sb strings.Builder
+	for i, v := range g.cells {
+		if i > 0 && i%g.Width == 0 {
+			sb.WriteByte('\n')
+		}
+
+		switch v := any(v).(type) {
+		case rune:
+			sb.WriteRune(v)
+		case byte:
+			sb.WriteByte(v)
+		default:
+			fmt.Fprint(&sb, v)
+		}
+	}
+	return sb.String()
+}
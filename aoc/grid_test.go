@@ -0,0 +1,176 @@
+package aoc
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// abc is a grid wider than it is tall so swapped coordinates go out of bounds
+func abc(t *testing.T) *Grid[rune] {
+	t.Helper()
+
+	g, err := ParseRuneGrid([]string{"abc", "def"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParseGrid(t *testing.T) {
+	g := abc(t)
+	if g.Width != 3 || g.Height != 2 {
+		t.Fatalf("grid is %dx%d, want 3x2", g.Width, g.Height)
+	}
+
+	if got := g.At(Point{X: 2, Y: 1}); got != 'f' {
+		t.Errorf("At(2, 1) = %q, want 'f'", got)
+	}
+
+	if got, want := g.String(), "abc\ndef"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestParseGridErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		lines  []string
+		line   int
+		column int
+	}{
+		{name: "short row", lines: []string{"abc", "de"}, line: 2, column: 3},
+		{name: "long row", lines: []string{"abc", "defg", "hij"}, line: 2, column: 4},
+		// columns are counted in bytes, so the caret lines up under the text
+		{name: "multi-byte", lines: []string{"éé", "ééé"}, line: 2, column: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseRuneGrid(test.lines)
+
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseRuneGrid() error = %v, want a *ParseError", err)
+			}
+
+			if perr.Line != test.line || perr.Column != test.column {
+				t.Errorf("error at %d:%d, want %d:%d", perr.Line, perr.Column, test.line, test.column)
+			}
+		})
+	}
+}
+
+func TestGridBounds(t *testing.T) {
+	g := abc(t)
+
+	tests := []struct {
+		p    Point
+		want rune
+		in   bool
+	}{
+		{p: Point{X: 0, Y: 0}, want: 'a', in: true},
+		{p: Point{X: 2, Y: 0}, want: 'c', in: true},
+		{p: Point{X: 0, Y: 1}, want: 'd', in: true},
+		{p: Point{X: 2, Y: 1}, want: 'f', in: true},
+		{p: Point{X: 1, Y: 2}},
+		{p: Point{X: 3, Y: 0}},
+		{p: Point{X: -1, Y: 0}},
+		{p: Point{X: 0, Y: -1}},
+	}
+
+	for _, test := range tests {
+		if in := g.In(test.p); in != test.in {
+			t.Errorf("In(%v) = %t, want %t", test.p, in, test.in)
+		}
+
+		got, ok := g.Get(test.p)
+		if got != test.want || ok != test.in {
+			t.Errorf("Get(%v) = %q, %t, want %q, %t", test.p, got, ok, test.want, test.in)
+		}
+	}
+}
+
+func TestGridAtOutOfBoundsPanics(t *testing.T) {
+	g := abc(t)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("At() out of bounds didn't panic")
+		}
+	}()
+
+	// in range of the cells slice, but not of the grid
+	g.At(Point{X: 3, Y: 0})
+}
+
+func TestGridNeighbours(t *testing.T) {
+	g := abc(t)
+
+	tests := []struct {
+		name      string
+		p         Point
+		diagonals bool
+		want      map[Point]rune
+	}{
+		{
+			name: "4 at corner",
+			p:    Point{X: 0, Y: 0},
+			want: map[Point]rune{{X: 1, Y: 0}: 'b', {X: 0, Y: 1}: 'd'},
+		},
+		{
+			name: "4 at edge",
+			p:    Point{X: 1, Y: 1},
+			want: map[Point]rune{{X: 1, Y: 0}: 'b', {X: 0, Y: 1}: 'd', {X: 2, Y: 1}: 'f'},
+		},
+		{
+			name:      "8 at corner",
+			p:         Point{X: 2, Y: 1},
+			diagonals: true,
+			want:      map[Point]rune{{X: 1, Y: 0}: 'b', {X: 2, Y: 0}: 'c', {X: 1, Y: 1}: 'e'},
+		},
+		{
+			name:      "8 at edge",
+			p:         Point{X: 1, Y: 0},
+			diagonals: true,
+			want: map[Point]rune{
+				{X: 0, Y: 0}: 'a', {X: 2, Y: 0}: 'c',
+				{X: 0, Y: 1}: 'd', {X: 1, Y: 1}: 'e', {X: 2, Y: 1}: 'f',
+			},
+		},
+		{
+			name: "outside the grid",
+			p:    Point{X: 3, Y: 1},
+			want: map[Point]rune{{X: 2, Y: 1}: 'f'},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			neighbours := g.Neighbours4
+			if test.diagonals {
+				neighbours = g.Neighbours8
+			}
+
+			if got := maps.Collect(neighbours(test.p)); !maps.Equal(got, test.want) {
+				t.Errorf("neighbours of %v = %v, want %v", test.p, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGridClone(t *testing.T) {
+	g := abc(t)
+	clone := g.Clone()
+	clone.Set(Point{X: 0, Y: 0}, 'z')
+
+	if got := g.At(Point{X: 0, Y: 0}); got != 'a' {
+		t.Errorf("original At(0, 0) = %q after setting the clone, want 'a'", got)
+	}
+
+	found := clone.FindAll(func(r rune) bool { return r == 'e' || r == 'z' })
+	if want := []Point{{X: 0, Y: 0}, {X: 1, Y: 1}}; !slices.Equal(found, want) {
+		t.Errorf("FindAll() = %v, want %v", found, want)
+	}
+}
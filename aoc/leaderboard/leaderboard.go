@@ -0,0 +1,117 @@
+// Package leaderboard reads and renders Advent of Code private leaderboards.
+package leaderboard
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Leaderboard is the JSON document served for a private leaderboard.
+type Leaderboard struct {
+	Event   string            `json:"event"`
+	OwnerID int               `json:"owner_id"`
+	Members map[string]Member `json:"members"`
+}
+
+// Member is a participant of a private leaderboard.
+type Member struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"` // empty for anonymous users
+	Stars       int    `json:"stars"`
+	LocalScore  int    `json:"local_score"`
+	GlobalScore int    `json:"global_score"`
+	LastStarTS  int64  `json:"last_star_ts"`
+
+	// Days maps days to the parts solved, keyed by part
+	Days map[int]map[int]Star `json:"completion_day_level"`
+}
+
+// Star records when a part was solved.
+type Star struct {
+	TS    int64 `json:"get_star_ts"`
+	Index int   `json:"star_index"`
+}
+
+// Parse decodes a private leaderboard.
+func Parse(data []byte) (*Leaderboard, error) {
+	var l Leaderboard
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("failed to decode leaderboard: %w", err)
+	}
+
+	if l.Members == nil {
+		return nil, errors.New("leaderboard has no members")
+	}
+
+	if _, err := l.Year(); err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// Year returns the event the leaderboard belongs to.
+func (l *Leaderboard) Year() (int, error) {
+	year, err := strconv.Atoi(l.Event)
+	if err != nil {
+		return 0, fmt.Errorf("leaderboard has invalid event %q", l.Event)
+	}
+	return year, nil
+}
+
+// Ranked returns the members ordered by local score, highest first. Ties go
+// to the member with more stars and then to whoever got their last star
+// first.
+func (l *Leaderboard) Ranked() []Member {
+	members := slices.Collect(maps.Values(l.Members))
+	slices.SortFunc(members, func(a, b Member) int {
+		return cmp.Or(
+			cmp.Compare(b.LocalScore, a.LocalScore),
+			cmp.Compare(b.Stars, a.Stars),
+			cmp.Compare(a.LastStarTS, b.LastStarTS),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return members
+}
+
+// Days returns every day on which at least one member earned a star.
+func (l *Leaderboard) Days() []int {
+	seen := make(map[int]bool)
+	for _, m := range l.Members {
+		for day := range m.Days {
+			seen[day] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// DisplayName returns the member's name or, for anonymous users, a
+// placeholder like the site shows.
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// Completion returns how long after the puzzle unlocked the member solved a
+// part of a day, and whether they solved it at all.
+func (m Member) Completion(year, day, part int) (time.Duration, bool) {
+	star, ok := m.Days[day][part]
+	if !ok {
+		return 0, false
+	}
+	return time.Unix(star.TS, 0).Sub(Unlock(year, day)), true
+}
+
+// Unlock returns when a day's puzzle unlocks, midnight US Eastern time.
+func Unlock(year, day int) time.Time {
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,91 @@
+package leaderboard
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func loadFixture(t *testing.T) *Leaderboard {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/private.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestRanked(t *testing.T) {
+	l := loadFixture(t)
+
+	var ids []int
+	for _, m := range l.Ranked() {
+		ids = append(ids, m.ID)
+	}
+
+	// 202 and 303 tie on score and stars, 202 got their last star first
+	if want := []int{101, 202, 303, 404}; !slices.Equal(ids, want) {
+		t.Errorf("Ranked() = %v, want %v", ids, want)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	m := loadFixture(t).Members["101"]
+
+	tests := []struct {
+		day, part int
+		want      time.Duration
+		ok        bool
+	}{
+		{day: 1, part: 1, want: 5*time.Minute + 12*time.Second, ok: true},
+		{day: 1, part: 2, want: 9*time.Minute + 40*time.Second, ok: true},
+		{day: 3, part: 1},
+	}
+
+	for _, tt := range tests {
+		got, ok := m.Completion(2025, tt.day, tt.part)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Completion(2025, %d, %d) = %v, %v, want %v, %v", tt.day, tt.part, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	l := loadFixture(t)
+
+	want, err := os.ReadFile("testdata/private.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, l, l.Days()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := buf.String(); got != string(want) {
+		t.Errorf("Render mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"not json":      "<!DOCTYPE html>",
+		"no members":    `{"event": "2025"}`,
+		"invalid event": `{"event": "twenty", "members": {}}`,
+	}
+
+	for name, data := range tests {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: Parse() succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package leaderboard
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Render writes the members as a table ranked by local score. Each of days
+// gets a column showing how long after unlocking the member solved part one,
+// followed by the time part two took on top of that.
+func Render(w io.Writer, l *Leaderboard, days []int) error {
+	year, err := l.Year()
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	header := []string{"rank", "score", "stars", "name"}
+	for _, day := range days {
+		header = append(header, fmt.Sprintf("day %d", day))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	members := l.Ranked()
+
+	var rank int
+	for i, m := range members {
+		// members with the same score share a rank
+		if i == 0 || m.LocalScore != members[i-1].LocalScore {
+			rank = i + 1
+		}
+
+		row := []string{
+			fmt.Sprintf("%d)", rank),
+			fmt.Sprint(m.LocalScore),
+			fmt.Sprint(m.Stars),
+			m.DisplayName(),
+		}
+		for _, day := range days {
+			row = append(row, dayCell(m, year, day))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// dayCell renders e.g "1:02:03 +0:04:05" for a member who solved part one
+// 1h2m3s after unlock and part two 4m5s later
+func dayCell(m Member, year, day int) string {
+	first, ok := m.Completion(year, day, 1)
+	if !ok {
+		return "-"
+	}
+
+	second, ok := m.Completion(year, day, 2)
+	if !ok {
+		return formatDuration(first)
+	}
+
+	return formatDuration(first) + " +" + formatDuration(second-first)
+}
+
+// formatDuration renders d as h:mm:ss, with hours growing past 24
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d < 0 {
+		d = 0
+	}
+
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+}
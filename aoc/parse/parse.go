@@ -0,0 +1,310 @@
+// Package parse matches puzzle input lines against declarative patterns.
+//
+// A pattern is literal text mixed with placeholders such as "{int}-{int}".
+// Each placeholder names the type of the value it captures and may end in
+// "..." to capture a whitespace separated list of that type, as in
+// "{word}: {word...}". Literal braces are written doubled, so "{{{ints}}}"
+// matches a comma separated list of ints wrapped in braces and "{{ints}}"
+// matches the text "{ints}".
+//
+// The built-in types are:
+//
+//	int    a base 10 integer
+//	ints   a comma separated list of base 10 integers
+//	word   a non-empty run of non-whitespace characters
+//	str    any text, possibly empty
+//
+// Further types can be supplied per pattern through Types.
+package parse
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Type converts the text captured by a placeholder into a value.
+type Type func(s string) (any, error)
+
+// Types maps placeholder names to the Type used to convert their text.
+type Types map[string]Type
+
+// Func adapts a typed conversion function into a Type.
+func Func[T any](fn func(string) (T, error)) Type {
+	return func(s string) (any, error) {
+		return fn(s)
+	}
+}
+
+var builtins = Types{
+	"int":  Func(strconv.Atoi),
+	"ints": Func(Ints),
+	"word": Func(word),
+	"str":  Func(func(s string) (string, error) { return s, nil }),
+}
+
+// Ints parses a comma separated list of integers.
+func Ints(s string) ([]int, error) {
+	parts := strings.Split(s, ",")
+
+	ints := make([]int, len(parts))
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = v
+	}
+	return ints, nil
+}
+
+func word(s string) (string, error) {
+	if s == "" || strings.IndexFunc(s, unicode.IsSpace) >= 0 {
+		return "", fmt.Errorf("%q is not a word", s)
+	}
+	return s, nil
+}
+
+// Error describes where and why a line failed to match a pattern.
+type Error struct {
+	// Offset is the byte offset into the line at which matching failed
+	Offset int
+	// Text is the offending text starting at Offset
+	Text string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("column %d: %v", e.Offset+1, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type segment struct {
+	literal string
+
+	// placeholder fields, set when literal is empty
+	name   string
+	conv   Type
+	repeat bool
+}
+
+// Pattern is a compiled line pattern.
+type Pattern struct {
+	source   string
+	segments []segment
+}
+
+// Compile parses a pattern. Placeholders are resolved against the built-in
+// types and then types, which may be nil.
+func Compile(pattern string, types Types) (*Pattern, error) {
+	p := &Pattern{source: pattern}
+
+	var literal strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if (c == '{' || c == '}') && i+1 < len(pattern) && pattern[i+1] == c {
+			literal.WriteByte(c)
+			i++
+			continue
+		}
+
+		if c == '}' {
+			return nil, fmt.Errorf("pattern %q: unmatched } at %d, write }} for a literal brace", pattern, i)
+		}
+
+		if c != '{' {
+			literal.WriteByte(c)
+			continue
+		}
+
+		end := strings.IndexByte(pattern[i:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("pattern %q: unterminated placeholder at %d", pattern, i)
+		}
+
+		name := pattern[i+1 : i+end]
+		seg := segment{name: name}
+		if base, ok := strings.CutSuffix(name, "..."); ok {
+			seg.name = base
+			seg.repeat = true
+		}
+
+		if seg.conv = types[seg.name]; seg.conv == nil {
+			seg.conv = builtins[seg.name]
+		}
+		if seg.conv == nil {
+			return nil, fmt.Errorf("pattern %q: unknown type %q", pattern, seg.name)
+		}
+
+		if literal.Len() == 0 && len(p.segments) > 0 {
+			return nil, fmt.Errorf("pattern %q: placeholder %q must be separated from the previous one by literal text", pattern, name)
+		}
+
+		if literal.Len() > 0 {
+			p.segments = append(p.segments, segment{literal: literal.String()})
+			literal.Reset()
+		}
+		p.segments = append(p.segments, seg)
+		i += end
+	}
+
+	if literal.Len() > 0 {
+		p.segments = append(p.segments, segment{literal: literal.String()})
+	}
+
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the pattern is invalid.
+func MustCompile(pattern string, types Types) *Pattern {
+	p, err := Compile(pattern, types)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (p *Pattern) String() string {
+	return p.source
+}
+
+// NumFields returns the number of placeholders in the pattern.
+func (p *Pattern) NumFields() int {
+	var n int
+	for _, seg := range p.segments {
+		if seg.literal == "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Scan matches s against the pattern and stores the captured values in dests,
+// which must be pointers with one per placeholder. A placeholder captures text
+// up to the first occurrence of the literal that follows it. Repeated
+// placeholders must be scanned into a pointer to a slice.
+func (p *Pattern) Scan(s string, dests ...any) error {
+	if n := p.NumFields(); len(dests) != n {
+		return fmt.Errorf("pattern %q has %d fields, got %d destinations", p.source, n, len(dests))
+	}
+
+	var pos, field int
+	for i, seg := range p.segments {
+		if seg.literal != "" {
+			if !strings.HasPrefix(s[pos:], seg.literal) {
+				return &Error{Offset: pos, Text: s[pos:], Err: fmt.Errorf("expected %q", seg.literal)}
+			}
+			pos += len(seg.literal)
+			continue
+		}
+
+		// the placeholder extends up to the next literal or the end of s
+		end := len(s)
+		if i+1 < len(p.segments) {
+			next := p.segments[i+1].literal
+			idx := strings.Index(s[pos:], next)
+			if idx < 0 {
+				return &Error{Offset: pos, Text: s[pos:], Err: fmt.Errorf("expected %q after {%s}", next, seg.name)}
+			}
+			end = pos + idx
+		}
+
+		if err := seg.assign(s, pos, end, dests[field]); err != nil {
+			return err
+		}
+
+		pos = end
+		field++
+	}
+
+	if pos != len(s) {
+		return &Error{Offset: pos, Text: s[pos:], Err: fmt.Errorf("unexpected trailing text %q", s[pos:])}
+	}
+
+	return nil
+}
+
+func (seg segment) assign(s string, start, end int, dest any) error {
+	dv := reflect.ValueOf(dest)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() {
+		return fmt.Errorf("destination for {%s} must be a non-nil pointer, got %T", seg.name, dest)
+	}
+	dv = dv.Elem()
+
+	if !seg.repeat {
+		v, err := seg.convert(s[start:end], start)
+		if err != nil {
+			return err
+		}
+		return set(dv, v, seg.name)
+	}
+
+	if dv.Kind() != reflect.Slice {
+		return fmt.Errorf("destination for {%s...} must be a pointer to a slice, got %T", seg.name, dest)
+	}
+
+	items := reflect.MakeSlice(dv.Type(), 0, 0)
+	for offset, item := range fields(s[start:end]) {
+		v, err := seg.convert(item, start+offset)
+		if err != nil {
+			return err
+		}
+
+		elem := reflect.New(dv.Type().Elem()).Elem()
+		if err := set(elem, v, seg.name); err != nil {
+			return err
+		}
+		items = reflect.Append(items, elem)
+	}
+	dv.Set(items)
+
+	return nil
+}
+
+func (seg segment) convert(text string, offset int) (any, error) {
+	v, err := seg.conv(text)
+	if err != nil {
+		return nil, &Error{Offset: offset, Text: text, Err: fmt.Errorf("invalid {%s}: %w", seg.name, err)}
+	}
+	return v, nil
+}
+
+func set(dv reflect.Value, v any, name string) error {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !rv.Type().AssignableTo(dv.Type()) {
+		return fmt.Errorf("cannot store {%s} value of type %T in %s", name, v, dv.Type())
+	}
+	dv.Set(rv)
+	return nil
+}
+
+// fields iterates over the runs of non-whitespace characters in s along with
+// their byte offsets
+func fields(s string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		start := -1
+		for i, r := range s {
+			if !unicode.IsSpace(r) {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+
+			if start >= 0 && !yield(start, s[start:i]) {
+				return
+			}
+			start = -1
+		}
+
+		if start >= 0 {
+			yield(start, s[start:])
+		}
+	}
+}
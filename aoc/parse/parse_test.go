@@ -0,0 +1,218 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type point struct{ x, y int }
+
+var types = Types{
+	"point": Func(func(s string) (point, error) {
+		x, y, ok := strings.Cut(s, ",")
+		if !ok {
+			return point{}, errors.New("expected x,y")
+		}
+		px, errX := strconv.Atoi(x)
+		py, errY := strconv.Atoi(y)
+		return point{px, py}, errors.Join(errX, errY)
+	}),
+}
+
+// newDest returns a pointer to a new zero value of the same type as v
+func newDest(v any) any {
+	return reflect.New(reflect.TypeOf(v)).Interface()
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		pattern string
+		line    string
+		want    []any
+	}{
+		{pattern: "{int}", line: "42", want: []any{42}},
+		{pattern: "{int}", line: "-7", want: []any{-7}},
+		{pattern: "{int}-{int}", line: "11-22", want: []any{11, 22}},
+		{pattern: "{ints}", line: "1,2,3", want: []any{[]int{1, 2, 3}}},
+		{pattern: "{word}: {word}", line: "aaa: bbb", want: []any{"aaa", "bbb"}},
+		{pattern: "{str}!", line: "!", want: []any{""}},
+		{pattern: "say {str}", line: "say hello world", want: []any{"hello world"}},
+		{pattern: "{point} -> {point}", line: "1,2 -> 3,4", want: []any{point{1, 2}, point{3, 4}}},
+
+		// repeated placeholders
+		{pattern: "{int...}", line: "1  2\t3", want: []any{[]int{1, 2, 3}}},
+		{pattern: "{int...}", line: "  1 2  ", want: []any{[]int{1, 2}}},
+		{pattern: "{word}: {word...}", line: "you: bbb ccc", want: []any{"you", []string{"bbb", "ccc"}}},
+		{pattern: "[{int...}]", line: "[]", want: []any{[]int{}}},
+		{pattern: "{ints...}", line: "1,2 3", want: []any{[][]int{{1, 2}, {3}}}},
+		{pattern: "{point...}", line: "1,2 3,4", want: []any{[]point{{1, 2}, {3, 4}}}},
+
+		// escaped braces
+		{pattern: "{{{ints}}}", line: "{3,5,4}", want: []any{[]int{3, 5, 4}}},
+		{pattern: "{{ints}} {int}", line: "{ints} 7", want: []any{7}},
+		{pattern: "{int} {{", line: "1 {", want: []any{1}},
+		{pattern: "}}{int}{{", line: "}1{", want: []any{1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+"/"+test.line, func(t *testing.T) {
+			p, err := Compile(test.pattern, types)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dests := make([]any, len(test.want))
+			for i, want := range test.want {
+				dests[i] = newDest(want)
+			}
+
+			if err := p.Scan(test.line, dests...); err != nil {
+				t.Fatalf("Scan(%q) = %v", test.line, err)
+			}
+
+			for i, want := range test.want {
+				if got := reflect.ValueOf(dests[i]).Elem().Interface(); !reflect.DeepEqual(got, want) {
+					t.Errorf("field %d = %#v, want %#v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestScanMismatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		line    string
+		dests   []any
+		column  int
+		err     string
+	}{
+		{pattern: "{int}-{int}", line: "11+22", dests: []any{new(int), new(int)}, column: 1, err: `expected "-" after {int}`},
+		{pattern: "{int}-{int}", line: "11-x", dests: []any{new(int), new(int)}, column: 4, err: "invalid {int}"},
+		{pattern: "{int}-{int}", line: "11-22 ", dests: []any{new(int), new(int)}, column: 4, err: "invalid {int}"},
+		{pattern: "x={int}", line: "y=1", dests: []any{new(int)}, column: 1, err: `expected "x="`},
+		{pattern: "{int}.", line: "1.2", dests: []any{new(int)}, column: 3, err: "unexpected trailing text"},
+		{pattern: "{word}!", line: " !", dests: []any{new(string)}, column: 1, err: "invalid {word}"},
+		{pattern: "{ints}", line: "1,,2", dests: []any{new([]int)}, column: 1, err: "invalid {ints}"},
+		{pattern: ": {int...}", line: ": 1 22 x3", dests: []any{new([]int)}, column: 8, err: "invalid {int}"},
+		{pattern: "{point}", line: "1;2", dests: []any{new(point)}, column: 1, err: "expected x,y"},
+		// columns are byte offsets, so they line up with the text of the line
+		{pattern: "é {int}", line: "é x", dests: []any{new(int)}, column: 4, err: "invalid {int}"},
+		{pattern: "{{{ints}}}", line: "{1,2", dests: []any{new([]int)}, column: 2, err: `expected "}" after {ints}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+"/"+test.line, func(t *testing.T) {
+			p, err := Compile(test.pattern, types)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = p.Scan(test.line, test.dests...)
+
+			var perr *Error
+			if !errors.As(err, &perr) {
+				t.Fatalf("Scan(%q) error = %v, want a *Error", test.line, err)
+			}
+
+			if col := perr.Offset + 1; col != test.column {
+				t.Errorf("error at column %d, want %d", col, test.column)
+			}
+			if got := test.line[perr.Offset:]; !strings.HasPrefix(got, perr.Text) {
+				t.Errorf("error text %q is not the line from column %d, %q", perr.Text, test.column, got)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("error = %q, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestScanDestinations(t *testing.T) {
+	p := MustCompile("{int}: {int...}", nil)
+
+	tests := []struct {
+		name  string
+		dests []any
+	}{
+		{name: "too few", dests: []any{new(int)}},
+		{name: "too many", dests: []any{new(int), new([]int), new(int)}},
+		{name: "not a pointer", dests: []any{0, new([]int)}},
+		{name: "nil pointer", dests: []any{(*int)(nil), new([]int)}},
+		{name: "wrong type", dests: []any{new(string), new([]int)}},
+		{name: "repeat into non-slice", dests: []any{new(int), new(int)}},
+		{name: "wrong element type", dests: []any{new(int), new([]string)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := p.Scan("1: 2 3", test.dests...)
+			if err == nil {
+				t.Fatal("Scan() succeeded, want an error")
+			}
+
+			var perr *Error
+			if errors.As(err, &perr) {
+				t.Errorf("Scan() error = %v, want a destination error rather than a mismatch", err)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		err     string
+	}{
+		{pattern: "{int", err: "unterminated placeholder"},
+		{pattern: "{float}", err: `unknown type "float"`},
+		{pattern: "{int...", err: "unterminated placeholder"},
+		{pattern: "{int}{int}", err: "must be separated"},
+		{pattern: "{int} }", err: "unmatched }"},
+		{pattern: "{{int}", err: "unmatched }"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			_, err := Compile(test.pattern, nil)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Compile(%q) error = %v, want it to contain %q", test.pattern, err, test.err)
+			}
+		})
+	}
+}
+
+func TestNumFields(t *testing.T) {
+	tests := map[string]int{
+		"plain text":            0,
+		"{int}-{int}":           2,
+		"{word}: {word...}":     2,
+		"{{{ints}}}":            1,
+		"{{not a placeholder}}": 0,
+	}
+
+	for pattern, want := range tests {
+		if got := MustCompile(pattern, nil).NumFields(); got != want {
+			t.Errorf("%q has %d fields, want %d", pattern, got, want)
+		}
+	}
+}
+
+func TestCustomTypeShadowsBuiltin(t *testing.T) {
+	hex := Types{"int": Func(func(s string) (int, error) {
+		v, err := strconv.ParseInt(s, 16, 0)
+		return int(v), err
+	})}
+
+	var v int
+	if err := MustCompile("{int}", hex).Scan("ff", &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if v != 255 {
+		t.Errorf("{int} = %d, want 255", v)
+	}
+}
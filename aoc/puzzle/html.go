@@ -0,0 +1,203 @@
+package puzzle
+
+import (
+	"html"
+	"strings"
+)
+
+// node is an element or, when tag is empty, a run of text
+type node struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+// elements that never have children or a closing tag
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true,
+	"img": true, "input": true, "link": true, "meta": true, "source": true, "wbr": true,
+}
+
+// parseHTML builds a tree from a well-formed HTML page. It is deliberately
+// small: it understands tags, attributes, text, comments and entities, which
+// is all the puzzle pages use, and tolerates unbalanced closing tags.
+func parseHTML(page string) *node {
+	root := &node{tag: "#root"}
+	stack := []*node{root}
+
+	for len(page) > 0 {
+		top := stack[len(stack)-1]
+
+		lt := strings.IndexByte(page, '<')
+		if lt != 0 {
+			if lt < 0 {
+				lt = len(page)
+			}
+			top.children = append(top.children, &node{text: html.UnescapeString(page[:lt])})
+			page = page[lt:]
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(page, "<!--"):
+			end := strings.Index(page, "-->")
+			if end < 0 {
+				return root
+			}
+			page = page[end+3:]
+
+		case strings.HasPrefix(page, "<!"), strings.HasPrefix(page, "<?"):
+			end := strings.IndexByte(page, '>')
+			if end < 0 {
+				return root
+			}
+			page = page[end+1:]
+
+		case strings.HasPrefix(page, "</"):
+			end := strings.IndexByte(page, '>')
+			if end < 0 {
+				return root
+			}
+			tag := strings.ToLower(strings.TrimSpace(page[2:end]))
+			page = page[end+1:]
+
+			// pop up to and including the matching element, ignoring strays
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == tag {
+					stack = stack[:i]
+					break
+				}
+			}
+
+		default:
+			end := tagEnd(page)
+			if end < 0 {
+				top.children = append(top.children, &node{text: html.UnescapeString(page)})
+				return root
+			}
+
+			el, selfClosing := parseTag(page[1:end])
+			page = page[end+1:]
+			top.children = append(top.children, el)
+
+			if selfClosing || voidElements[el.tag] {
+				continue
+			}
+
+			// script and style contents aren't markup
+			if el.tag == "script" || el.tag == "style" {
+				closing := "</" + el.tag
+				idx := strings.Index(strings.ToLower(page), closing)
+				if idx < 0 {
+					return root
+				}
+				page = page[idx:]
+				continue
+			}
+
+			stack = append(stack, el)
+		}
+	}
+
+	return root
+}
+
+// tagEnd returns the index of the '>' closing the tag at the start of s,
+// skipping over quoted attribute values
+func tagEnd(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseTag parses the inside of an opening tag such as `a href="/2025"`
+func parseTag(s string) (*node, bool) {
+	s = strings.TrimSpace(s)
+	selfClosing := strings.HasSuffix(s, "/")
+	s = strings.TrimSuffix(s, "/")
+
+	nameEnd := strings.IndexAny(s, " \t\r\n")
+	if nameEnd < 0 {
+		nameEnd = len(s)
+	}
+
+	el := &node{tag: strings.ToLower(s[:nameEnd]), attrs: make(map[string]string)}
+	rest := s[nameEnd:]
+
+	for {
+		rest = strings.TrimLeft(rest, " \t\r\n")
+		if rest == "" {
+			break
+		}
+
+		keyEnd := strings.IndexAny(rest, "= \t\r\n")
+		if keyEnd < 0 {
+			el.attrs[strings.ToLower(rest)] = ""
+			break
+		}
+
+		key := strings.ToLower(rest[:keyEnd])
+		rest = strings.TrimLeft(rest[keyEnd:], " \t\r\n")
+		if !strings.HasPrefix(rest, "=") {
+			el.attrs[key] = ""
+			continue
+		}
+
+		rest = strings.TrimLeft(rest[1:], " \t\r\n")
+		var value string
+		if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			value, rest = rest[1:end+1], rest[min(end+2, len(rest)):]
+		} else {
+			end := strings.IndexAny(rest, " \t\r\n")
+			if end < 0 {
+				end = len(rest)
+			}
+			value, rest = rest[:end], rest[end:]
+		}
+
+		el.attrs[key] = html.UnescapeString(value)
+	}
+
+	return el, selfClosing
+}
+
+// findAll returns every element below n with the given tag in document order
+func (n *node) findAll(tag string) []*node {
+	var found []*node
+	for _, child := range n.children {
+		if child.tag == tag {
+			found = append(found, child)
+		}
+		found = append(found, child.findAll(tag)...)
+	}
+	return found
+}
+
+// textContent returns the concatenated text below n
+func (n *node) textContent() string {
+	if n.tag == "" {
+		return n.text
+	}
+
+	var sb strings.Builder
+	for _, child := range n.children {
+		sb.WriteString(child.textContent())
+	}
+	return sb.String()
+}
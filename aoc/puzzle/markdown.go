@@ -0,0 +1,217 @@
+package puzzle
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// renderer converts element trees into Markdown
+type renderer struct {
+	base *url.URL // resolves relative links
+}
+
+var blockElements = map[string]bool{
+	"p": true, "pre": true, "ul": true, "ol": true, "div": true, "section": true, "article": true,
+	"blockquote": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// blocks renders the children of n as Markdown blocks separated by blank
+// lines, leaving out elements with the skip tag
+func (r *renderer) blocks(n *node, skip string) string {
+	var blocks []string
+
+	// inline content between block elements forms its own paragraph
+	var loose strings.Builder
+	flush := func() {
+		if text := strings.TrimSpace(loose.String()); text != "" {
+			blocks = append(blocks, text)
+		}
+		loose.Reset()
+	}
+
+	for _, child := range n.children {
+		if child.tag == skip {
+			continue
+		}
+
+		if !blockElements[child.tag] {
+			r.inline(&loose, child)
+			continue
+		}
+
+		flush()
+		if block := r.block(child); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	flush()
+
+	return strings.Join(blocks, "\n\n")
+}
+
+func (r *renderer) block(n *node) string {
+	switch n.tag {
+	case "p":
+		return r.inlineText(n)
+	case "pre":
+		return fence(n.textContent())
+	case "ul", "ol":
+		return r.list(n, "")
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := max(int(n.tag[1]-'0'), 3)
+		return strings.Repeat("#", level) + " " + heading(n)
+	case "blockquote":
+		inner := r.blocks(n, "")
+		return "> " + strings.ReplaceAll(inner, "\n", "\n> ")
+	default:
+		return r.blocks(n, "")
+	}
+}
+
+// list renders a ul or ol element, nesting sublists under their items
+func (r *renderer) list(n *node, indent string) string {
+	var lines []string
+
+	var num int
+	for _, li := range n.children {
+		if li.tag != "li" {
+			continue
+		}
+		num++
+
+		marker := "- "
+		if n.tag == "ol" {
+			marker = fmt.Sprintf("%d. ", num)
+		}
+
+		var text strings.Builder
+		var nested []string
+		for _, child := range li.children {
+			switch child.tag {
+			case "ul", "ol":
+				nested = append(nested, r.list(child, indent+strings.Repeat(" ", len(marker))))
+			case "p":
+				text.WriteString(r.inlineText(child) + " ")
+			default:
+				r.inline(&text, child)
+			}
+		}
+
+		lines = append(lines, indent+marker+strings.TrimSpace(text.String()))
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r *renderer) inlineText(n *node) string {
+	var sb strings.Builder
+	for _, child := range n.children {
+		r.inline(&sb, child)
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func (r *renderer) inline(sb *strings.Builder, n *node) {
+	switch n.tag {
+	case "":
+		sb.WriteString(escape(collapse(n.text)))
+
+	case "code":
+		code := inlineCode(n.textContent())
+		// the site highlights important values with <code><em>...</em></code>
+		if len(n.children) == 1 && n.children[0].tag == "em" {
+			code = "*" + code + "*"
+		}
+		sb.WriteString(code)
+
+	case "em", "i":
+		r.wrap(sb, n, "*")
+
+	case "strong", "b":
+		r.wrap(sb, n, "**")
+
+	case "a":
+		text := r.inlineText(n)
+		href, ok := n.attrs["href"]
+		if !ok {
+			sb.WriteString(text)
+			return
+		}
+
+		if ref, err := url.Parse(href); err == nil {
+			href = r.base.ResolveReference(ref).String()
+		}
+		fmt.Fprintf(sb, "[%s](%s)", text, href)
+
+	case "br":
+		sb.WriteString("  \n")
+
+	case "script", "style":
+
+	default:
+		for _, child := range n.children {
+			r.inline(sb, child)
+		}
+	}
+}
+
+// wrap renders n between delimiters, keeping surrounding whitespace outside
+// them so the emphasis stays valid Markdown
+func (r *renderer) wrap(sb *strings.Builder, n *node, delim string) {
+	var inner strings.Builder
+	for _, child := range n.children {
+		r.inline(&inner, child)
+	}
+
+	text := inner.String()
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		sb.WriteString(text)
+		return
+	}
+
+	if strings.HasPrefix(text, " ") {
+		sb.WriteByte(' ')
+	}
+	sb.WriteString(delim + trimmed + delim)
+	if strings.HasSuffix(text, " ") {
+		sb.WriteByte(' ')
+	}
+}
+
+// fence renders text as a fenced code block, using a fence longer than any
+// run of backticks in text
+func fence(text string) string {
+	text = strings.TrimSuffix(text, "\n")
+
+	ticks := "```"
+	for strings.Contains(text, ticks) {
+		ticks += "`"
+	}
+	return ticks + "\n" + text + "\n" + ticks
+}
+
+func inlineCode(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	if strings.Contains(text, "`") {
+		return "`` " + text + " ``"
+	}
+	return "`" + text + "`"
+}
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+// collapse replaces runs of whitespace with a single space as browsers do
+func collapse(s string) string {
+	return whitespace.ReplaceAllString(s, " ")
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+// escape keeps text containing Markdown syntax characters literal
+func escape(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,116 @@
+// Package puzzle converts Advent of Code puzzle pages into Markdown.
+//
+// A puzzle page holds one <article class="day-desc"> per unlocked part. The
+// articles are rendered as Markdown with code blocks, inline code, emphasis,
+// links and lists preserved, and the first <pre><code> block, which is almost
+// always the worked example, is extracted verbatim.
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Puzzle is the parsed description of a day's puzzle.
+type Puzzle struct {
+	Title string // e.g "Day 1: Secret Entrance"
+	URL   string // address the page was downloaded from
+
+	// Parts holds the Markdown of each unlocked part, without its heading
+	Parts []string
+
+	// Example is the text of the first code block of part one, empty if the
+	// puzzle has none
+	Example string
+}
+
+// Parse extracts the puzzle description from the HTML of a puzzle page.
+// Relative links are resolved against pageURL.
+func Parse(page []byte, pageURL string) (*Puzzle, error) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page URL: %w", err)
+	}
+
+	articles := parseHTML(string(page)).findAll("article")
+	articles = filter(articles, func(n *node) bool {
+		return hasClass(n, "day-desc")
+	})
+
+	if len(articles) == 0 {
+		return nil, errors.New("page has no puzzle description")
+	}
+
+	p := &Puzzle{URL: pageURL}
+	r := &renderer{base: base}
+
+	for i, article := range articles {
+		if i == 0 {
+			if h2 := article.findAll("h2"); len(h2) > 0 {
+				p.Title = heading(h2[0])
+			}
+
+			for _, pre := range article.findAll("pre") {
+				if code := pre.findAll("code"); len(code) > 0 {
+					p.Example = code[0].textContent()
+					break
+				}
+			}
+		}
+
+		p.Parts = append(p.Parts, r.blocks(article, "h2"))
+	}
+
+	return p, nil
+}
+
+// Markdown renders the whole puzzle as a Markdown document.
+func (p *Puzzle) Markdown() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", p.Title)
+	if p.URL != "" {
+		fmt.Fprintf(&sb, "<%s>\n\n", p.URL)
+	}
+
+	for i, part := range p.Parts {
+		name := strconv.Itoa(i + 1)
+		if i < len(partNames) {
+			name = partNames[i]
+		}
+
+		fmt.Fprintf(&sb, "## Part %s\n\n%s\n", name, part)
+		if i < len(p.Parts)-1 {
+			sb.WriteByte('\n')
+		}
+	}
+
+	return sb.String()
+}
+
+var partNames = []string{"One", "Two"}
+
+// headingDashes matches the dashes decorating headings, as in "--- Part Two ---"
+var headingDashes = regexp.MustCompile(`^-+\s*|\s*-+$`)
+
+func heading(n *node) string {
+	return headingDashes.ReplaceAllString(strings.TrimSpace(collapse(n.textContent())), "")
+}
+
+func hasClass(n *node, class string) bool {
+	return strings.Contains(" "+n.attrs["class"]+" ", " "+class+" ")
+}
+
+func filter(nodes []*node, keep func(*node) bool) []*node {
+	var kept []*node
+	for _, n := range nodes {
+		if keep(n) {
+			kept = append(kept, n)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,62 @@
+package puzzle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const pageURL = "https://adventofcode.com/2025/day/1"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		page  string
+		parts int
+	}{
+		{page: "part1", parts: 1},
+		{page: "part2", parts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.page, func(t *testing.T) {
+			html, err := os.ReadFile(filepath.Join("testdata", tt.page+".html"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := os.ReadFile(filepath.Join("testdata", tt.page+".md"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p, err := Parse(html, pageURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if p.Title != "Day 1: Lantern Counting" {
+				t.Errorf("Title = %q", p.Title)
+			}
+
+			if len(p.Parts) != tt.parts {
+				t.Errorf("got %d parts, want %d", len(p.Parts), tt.parts)
+			}
+
+			if p.Example != "+\n-\n+\n+\n" {
+				t.Errorf("Example = %q", p.Example)
+			}
+
+			if got := p.Markdown(); got != string(want) {
+				t.Errorf("Markdown mismatch\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestParseWithoutPuzzle(t *testing.T) {
+	page := "<html><body><main><article><p>You don't seem to be solving the right level.</p></article></main></body></html>"
+	if _, err := Parse([]byte(page), pageURL); err == nil || !strings.Contains(err.Error(), "no puzzle") {
+		t.Errorf("Parse() error = %v, want a missing puzzle error", err)
+	}
+}
@@ -1,33 +1,15 @@
 package aoc
 
-import (
-	"bufio"
-	"os"
-)
+import "io"
 
-func ReadInputLineByLine(filename string) ([]string, error) {
-	var lines []string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		if scanner.Err() != nil {
-			return nil, err
-		}
-
-		lines = append(lines, scanner.Text())
-	}
-
-	return lines, nil
+// ReadLines reads every line from r.
+func ReadLines(r io.Reader) ([]string, error) {
+	return Collect(Lines(r))
 }
 
-func ReadInput(filename string) (string, error) {
-	data, err := os.ReadFile(filename)
+// ReadString reads r in full.
+func ReadString(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
 	if err != nil {
 		return "", err
 	}
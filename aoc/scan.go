@@ -0,0 +1,72 @@
+package aoc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// DefaultMaxTokenSize is the largest line or token the iterators accept
+// unless overridden with MaxTokenSize.
+const DefaultMaxTokenSize = 1 << 20
+
+type scanConfig struct {
+	maxTokenSize int
+}
+
+// ScanOption configures the iterators returned by Scan and Lines.
+type ScanOption func(*scanConfig)
+
+// MaxTokenSize sets the largest line or token, in bytes, that can be read.
+func MaxTokenSize(n int) ScanOption {
+	return func(cfg *scanConfig) {
+		cfg.maxTokenSize = n
+	}
+}
+
+// Lines returns an iterator over the lines in r with line endings stripped.
+func Lines(r io.Reader, opts ...ScanOption) iter.Seq2[string, error] {
+	return Scan(r, bufio.ScanLines, opts...)
+}
+
+// Scan returns an iterator over the tokens produced by split. Reading stops at
+// the first error, which is yielded once with an empty token.
+func Scan(r io.Reader, split bufio.SplitFunc, opts ...ScanOption) iter.Seq2[string, error] {
+	cfg := scanConfig{maxTokenSize: DefaultMaxTokenSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(string, error) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, min(cfg.maxTokenSize, bufio.MaxScanTokenSize)), cfg.maxTokenSize)
+		scanner.Split(split)
+
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			if errors.Is(err, bufio.ErrTooLong) {
+				err = fmt.Errorf("token exceeds %d bytes: %w", cfg.maxTokenSize, err)
+			}
+			yield("", err)
+		}
+	}
+}
+
+// Collect drains seq into a slice, returning the first error it yields.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
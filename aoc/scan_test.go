@@ -0,0 +1,97 @@
+package aoc
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLines(t *testing.T) {
+	long := strings.Repeat("x", bufio.MaxScanTokenSize+1)
+
+	tests := []struct {
+		name  string
+		input string
+		opts  []ScanOption
+		want  []string
+		isErr error
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "final newline", input: "a\nb\n", want: []string{"a", "b"}},
+		{name: "no final newline", input: "a\nb", want: []string{"a", "b"}},
+		{name: "blank lines", input: "a\n\n\nb\n", want: []string{"a", "", "", "b"}},
+		{name: "crlf", input: "a\r\nb\r\n", want: []string{"a", "b"}},
+		{name: "crlf without final newline", input: "a\r\nb", want: []string{"a", "b"}},
+		{name: "longer than bufio default", input: long + "\nb", want: []string{long, "b"}},
+		{name: "at max token size", input: "abcd\nab", opts: []ScanOption{MaxTokenSize(5)}, want: []string{"abcd", "ab"}},
+		{name: "past max token size", input: "ab\nabcdef\n", opts: []ScanOption{MaxTokenSize(5)}, isErr: bufio.ErrTooLong},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Collect(Lines(strings.NewReader(test.input), test.opts...))
+			if test.isErr != nil {
+				if !errors.Is(err, test.isErr) {
+					t.Fatalf("Collect(Lines()) error = %v, want %v", err, test.isErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Collect(Lines()) = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLinesYieldsErrorOnce(t *testing.T) {
+	readErr := errors.New("disk on fire")
+
+	var lines []string
+	var errs []error
+	for line, err := range Lines(iotest.ErrReader(readErr)) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) != 0 || len(errs) != 1 || !errors.Is(errs[0], readErr) {
+		t.Errorf("Lines() yielded lines %q and errors %v, want one %v", lines, errs, readErr)
+	}
+}
+
+func TestLinesStopsEarly(t *testing.T) {
+	var got []string
+	for line, err := range Lines(strings.NewReader("a\nb\nc\n")) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, line)
+		if line == "b" {
+			break
+		}
+	}
+
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("lines before break = %q, want %q", got, want)
+	}
+}
+
+func TestScanSplit(t *testing.T) {
+	got, err := Collect(Scan(strings.NewReader("one two\tthree\n"), bufio.ScanWords))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"one", "two", "three"}; !slices.Equal(got, want) {
+		t.Errorf("Collect(Scan(ScanWords)) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package aoc
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AnySections can be passed to Sections to accept any number of sections.
+const AnySections = -1
+
+// Section is a block of input lines delimited by blank lines.
+type Section struct {
+	// Name is set when the section opens with a header line such as "0:", in
+	// which case the header is not included in Lines
+	Name  string
+	Line  int // 1-based line number of Lines[0] within the input
+	Lines []string
+}
+
+// Text returns the section's lines joined by newlines.
+func (s Section) Text() string {
+	return strings.Join(s.Lines, "\n")
+}
+
+// Sections splits input into sections separated by one or more blank lines.
+// Windows line endings and trailing whitespace are removed from every line
+// and lines containing only whitespace count as blank. It returns an error
+// unless exactly count sections are found, or count is AnySections.
+func Sections(input string, count int) ([]Section, error) {
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+
+	var sections []Section
+	var curr *Section
+	for i, line := range strings.Split(input, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+
+		if line == "" {
+			curr = nil
+			continue
+		}
+
+		if curr == nil {
+			sections = append(sections, Section{Line: i + 1})
+			curr = &sections[len(sections)-1]
+
+			if name, ok := sectionHeader(line); ok {
+				curr.Name = name
+				curr.Line++
+				continue
+			}
+		}
+
+		curr.Lines = append(curr.Lines, line)
+	}
+
+	if count == AnySections || len(sections) == count {
+		return sections, nil
+	}
+
+	if len(sections) > count {
+		extra := sections[count]
+		line := extra.Line
+		if extra.Name != "" {
+			line--
+		}
+
+		return nil, &ParseError{
+			Line: line,
+			Text: extra.firstLine(),
+			Err:  fmt.Errorf("unexpected section, expected %d sections separated by blank lines", count),
+		}
+	}
+
+	return nil, fmt.Errorf("expected %d sections separated by blank lines, found %d", count, len(sections))
+}
+
+// sectionHeader reports whether line is a header such as "0:" and returns the
+// name it declares
+func sectionHeader(line string) (string, bool) {
+	name, ok := strings.CutSuffix(line, ":")
+	if !ok || name == "" || strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+		return "", false
+	}
+	return name, true
+}
+
+// firstLine returns the first line of the section as it appeared in the input
+func (s Section) firstLine() string {
+	if s.Name != "" {
+		return s.Name + ":"
+	}
+	if len(s.Lines) > 0 {
+		return s.Lines[0]
+	}
+	return ""
+}
@@ -0,0 +1,148 @@
+package aoc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Section
+	}{
+		{
+			name:  "one blank line",
+			input: "3-5\n10-14\n\n1\n5\n",
+			want: []Section{
+				{Line: 1, Lines: []string{"3-5", "10-14"}},
+				{Line: 4, Lines: []string{"1", "5"}},
+			},
+		},
+		{
+			name:  "runs of blank lines",
+			input: "\n\na\n\n\n\nb\n\n",
+			want: []Section{
+				{Line: 3, Lines: []string{"a"}},
+				{Line: 7, Lines: []string{"b"}},
+			},
+		},
+		{
+			name:  "whitespace only lines are blank",
+			input: "a\n \t\nb",
+			want: []Section{
+				{Line: 1, Lines: []string{"a"}},
+				{Line: 3, Lines: []string{"b"}},
+			},
+		},
+		{
+			name:  "crlf and trailing whitespace",
+			input: "a  \r\nb\r\n\r\nc\t\r\n",
+			want: []Section{
+				{Line: 1, Lines: []string{"a", "b"}},
+				{Line: 4, Lines: []string{"c"}},
+			},
+		},
+		{
+			name:  "leading whitespace is kept",
+			input: "  a\n b",
+			want:  []Section{{Line: 1, Lines: []string{"  a", " b"}}},
+		},
+		{
+			name:  "headers",
+			input: "0:\n##\n\n1:\n#.\n\n4x4: 0 1\n",
+			want: []Section{
+				{Name: "0", Line: 2, Lines: []string{"##"}},
+				{Name: "1", Line: 5, Lines: []string{"#."}},
+				{Line: 7, Lines: []string{"4x4: 0 1"}},
+			},
+		},
+		{
+			name:  "header without lines",
+			input: "a:\n\nb",
+			want: []Section{
+				{Name: "a", Line: 2},
+				{Line: 3, Lines: []string{"b"}},
+			},
+		},
+		{
+			name:  "only the first line is a header",
+			input: "x\ny:\n",
+			want:  []Section{{Line: 1, Lines: []string{"x", "y:"}}},
+		},
+		{
+			name:  "not headers",
+			input: ":\n\na b:\n",
+			want: []Section{
+				{Line: 1, Lines: []string{":"}},
+				{Line: 3, Lines: []string{"a b:"}},
+			},
+		},
+		{
+			name:  "empty",
+			input: "\n\n",
+			want:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Sections(test.input, AnySections)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Sections() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSectionsCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		count  int
+		line   int // line of the ParseError, 0 if the error isn't one
+		text   string
+		errMsg string
+	}{
+		{name: "too few", input: "a\nb\n", count: 2, errMsg: "expected 2 sections separated by blank lines, found 1"},
+		{name: "none", input: "", count: 1, errMsg: "expected 1 sections separated by blank lines, found 0"},
+		{name: "too many", input: "a\n\nb\n\n\nc\n", count: 2, line: 6, text: "c"},
+		{name: "too many with header", input: "a\n\nb:\nc\n", count: 1, line: 3, text: "b:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Sections(test.input, test.count)
+			if err == nil {
+				t.Fatal("Sections() succeeded, want an error")
+			}
+
+			var perr *ParseError
+			if test.line == 0 {
+				if errors.As(err, &perr) || err.Error() != test.errMsg {
+					t.Errorf("Sections() error = %q, want %q", err, test.errMsg)
+				}
+				return
+			}
+
+			if !errors.As(err, &perr) {
+				t.Fatalf("Sections() error = %v, want a *ParseError", err)
+			}
+			if perr.Line != test.line || perr.Text != test.text {
+				t.Errorf("error on line %d: %q, want %d: %q", perr.Line, perr.Text, test.line, test.text)
+			}
+		})
+	}
+}
+
+func TestSectionText(t *testing.T) {
+	s := Section{Lines: []string{"a", "", "b"}}
+	if got, want := s.Text(), "a\n\nb"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
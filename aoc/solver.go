@@ -0,0 +1,112 @@
+package aoc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+)
+
+// ErrNoPart is returned for parts that have no puzzle, such as the second part
+// of the final day.
+var ErrNoPart = errors.New("part has no puzzle")
+
+// Solver solves a single day's puzzle. Parse is called once with the puzzle
+// input before either part is solved. Long running parts should give up with
+// ctx.Err() once ctx is done.
+type Solver interface {
+	Parse(r io.Reader) error
+	PartOne(ctx context.Context) (int, error)
+	PartTwo(ctx context.Context) (int, error)
+}
+
+// Settings are named parameters of a puzzle that its examples use different
+// values for than the real input, such as how many pairs day 8 connects.
+type Settings map[string]int
+
+// Configurable is implemented by solvers of puzzles with Settings. Configure
+// is called before Parse, and only for examples whose golden file records
+// settings, so a solver starts out configured for the real input.
+type Configurable interface {
+	Configure(settings Settings) error
+}
+
+// DefaultYear is the event the repository was started for. Its days live
+// directly under the repository root while other years get a directory each.
+const DefaultYear = 2025
+
+// Day is a registered puzzle.
+type Day struct {
+	Year int
+	Day  int
+	// Examples holds the day's examples/*.txt files
+	Examples fs.FS
+	// New returns a fresh solver for the day
+	New func() Solver
+}
+
+// Dir returns the day's directory relative to the repository root.
+func (d Day) Dir() string {
+	return DayDir(d.Year, d.Day)
+}
+
+// String returns a label such as "day 05", prefixed with the year for days
+// outside DefaultYear as in "2024 day 05".
+func (d Day) String() string {
+	if d.Year == DefaultYear {
+		return fmt.Sprintf("day %02d", d.Day)
+	}
+	return fmt.Sprintf("%d day %02d", d.Year, d.Day)
+}
+
+// DayDir returns the directory of a day relative to the repository root,
+// day_05 for DefaultYear and 2024/day_05 for other years.
+func DayDir(year, day int) string {
+	if year == DefaultYear {
+		return fmt.Sprintf("day_%02d", day)
+	}
+	return filepath.Join(strconv.Itoa(year), fmt.Sprintf("day_%02d", day))
+}
+
+type dayKey struct {
+	year, day int
+}
+
+var registry = make(map[dayKey]Day)
+
+// Register adds a day of DefaultYear to the registry. It is meant to be
+// called from the init function of each day's package and panics if the day
+// is already registered.
+func Register(day int, examples fs.FS, newSolver func() Solver) {
+	RegisterYear(DefaultYear, day, examples, newSolver)
+}
+
+// RegisterYear is like Register for a day of any year.
+func RegisterYear(year, day int, examples fs.FS, newSolver func() Solver) {
+	key := dayKey{year: year, day: day}
+	if _, exists := registry[key]; exists {
+		panic(fmt.Sprintf("aoc: %d day %d registered twice", year, day))
+	}
+	registry[key] = Day{Year: year, Day: day, Examples: examples, New: newSolver}
+}
+
+// Lookup returns the registered day of a year.
+func Lookup(year, day int) (Day, bool) {
+	d, ok := registry[dayKey{year: year, day: day}]
+	return d, ok
+}
+
+// Days returns every registered day ordered by year and then day.
+func Days() []Day {
+	days := slices.Collect(maps.Values(registry))
+	slices.SortFunc(days, func(a, b Day) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Day, b.Day))
+	})
+	return days
+}
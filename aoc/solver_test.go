@@ -0,0 +1,133 @@
+package aoc
+
+import (
+	"io"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// useRegistry swaps in an empty registry of days and generators until the
+// test ends
+func useRegistry(t *testing.T) {
+	t.Helper()
+
+	days, gens := registry, generators
+	registry, generators = make(map[dayKey]Day), make(map[dayKey]Generator)
+	t.Cleanup(func() { registry, generators = days, gens })
+}
+
+func newNilSolver() Solver {
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	useRegistry(t)
+
+	// registered out of order
+	RegisterYear(2024, 3, nil, newNilSolver)
+	Register(12, nil, newNilSolver)
+	RegisterYear(2015, 25, nil, newNilSolver)
+	Register(1, nil, newNilSolver)
+	RegisterYear(2024, 1, nil, newNilSolver)
+
+	var got []dayKey
+	for _, day := range Days() {
+		got = append(got, dayKey{year: day.Year, day: day.Day})
+	}
+	want := []dayKey{{2015, 25}, {2024, 1}, {2024, 3}, {DefaultYear, 1}, {DefaultYear, 12}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Days() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		year, day int
+		ok        bool
+	}{
+		{year: DefaultYear, day: 1, ok: true},
+		{year: DefaultYear, day: 12, ok: true},
+		{year: 2024, day: 3, ok: true},
+		{year: 2015, day: 25, ok: true},
+		{year: DefaultYear, day: 3},
+		{year: 2024, day: 12},
+		{year: 2023, day: 1},
+		{year: 0, day: 0},
+	}
+
+	for _, test := range tests {
+		day, ok := Lookup(test.year, test.day)
+		if ok != test.ok {
+			t.Errorf("Lookup(%d, %d) found = %t, want %t", test.year, test.day, ok, test.ok)
+			continue
+		}
+		if ok && (day.Year != test.year || day.Day != test.day || day.New == nil) {
+			t.Errorf("Lookup(%d, %d) = %+v", test.year, test.day, day)
+		}
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	gen := func(io.Writer, *rand.Rand, int) error { return nil }
+
+	tests := []struct {
+		name     string
+		register []func()
+		panics   bool
+	}{
+		{
+			name: "same day",
+			register: []func(){
+				func() { Register(5, nil, newNilSolver) },
+				func() { Register(5, nil, newNilSolver) },
+			},
+			panics: true,
+		},
+		{
+			name: "default year both ways",
+			register: []func(){
+				func() { Register(5, nil, newNilSolver) },
+				func() { RegisterYear(DefaultYear, 5, nil, newNilSolver) },
+			},
+			panics: true,
+		},
+		{
+			name: "same day of another year",
+			register: []func(){
+				func() { Register(5, nil, newNilSolver) },
+				func() { RegisterYear(2024, 5, nil, newNilSolver) },
+			},
+		},
+		{
+			name: "same generator",
+			register: []func(){
+				func() { RegisterGenerator(5, gen) },
+				func() { RegisterGeneratorYear(DefaultYear, 5, gen) },
+			},
+			panics: true,
+		},
+		{
+			name: "generator of a registered day",
+			register: []func(){
+				func() { Register(5, nil, newNilSolver) },
+				func() { RegisterGenerator(5, gen) },
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useRegistry(t)
+
+			for _, register := range test.register[:len(test.register)-1] {
+				register()
+			}
+
+			defer func() {
+				if panicked := recover() != nil; panicked != test.panics {
+					t.Errorf("last registration panicked = %t, want %t", panicked, test.panics)
+				}
+			}()
+			test.register[len(test.register)-1]()
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package aoc
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// StdinName is the input name that reads from standard input
+	StdinName = "-"
+
+	// ExamplePrefix prefixes the input names of embedded examples e.g example:1
+	ExamplePrefix = "example:"
+)
+
+// Open opens the named puzzle input. The name is either StdinName, an example
+// name such as "example:1" resolved to examples/1.txt within examples, or a
+// path on disk. Files ending in .gz are decompressed transparently.
+func Open(name string, examples fs.FS) (io.ReadCloser, error) {
+	if name == StdinName {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	var file io.ReadCloser
+	var err error
+	if id, ok := strings.CutPrefix(name, ExamplePrefix); ok {
+		file, err = openExample(id, examples)
+	} else {
+		file, err = os.Open(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(name, ".gz") {
+		return file, nil
+	}
+
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to decompress %s: %w", name, err)
+	}
+
+	return &gzipFile{Reader: zr, file: file}, nil
+}
+
+func openExample(id string, examples fs.FS) (fs.File, error) {
+	if examples == nil {
+		return nil, errors.New("no examples are embedded for this day")
+	}
+
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("invalid example name %q", id)
+	}
+
+	return examples.Open("examples/" + id + ".txt")
+}
+
+// Configure applies the settings recorded for the named input to solver, if
+// it is Configurable. Only examples have settings, which their golden file
+// records as "name = value" lines next to the answers.
+func Configure(solver Solver, name string, examples fs.FS) error {
+	c, ok := solver.(Configurable)
+	if !ok {
+		return nil
+	}
+
+	id, ok := strings.CutPrefix(name, ExamplePrefix)
+	if !ok {
+		return nil
+	}
+
+	settings, err := ExampleSettings(examples, id)
+	if err != nil || len(settings) == 0 {
+		return err
+	}
+	return c.Configure(settings)
+}
+
+// ExampleSettings returns the settings recorded in the golden file of an
+// example, examples/<id>.golden, which are none if it has no golden file.
+func ExampleSettings(examples fs.FS, id string) (Settings, error) {
+	if examples == nil {
+		return nil, nil
+	}
+
+	name := "examples/" + id + ".golden"
+	data, err := fs.ReadFile(examples, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(Settings)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		key, rawValue, ok := strings.Cut(line, "=")
+		if !ok || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		value, err := strconv.Atoi(strings.TrimSpace(rawValue))
+		if key == "" || err != nil {
+			return nil, fmt.Errorf("%s:%d: expected \"<name> = <integer>\", got %q", name, i+1, line)
+		}
+		settings[key] = value
+	}
+
+	return settings, nil
+}
+
+// gzipFile closes both the gzip stream and the underlying file
+type gzipFile struct {
+	*gzip.Reader
+	file io.Closer
+}
+
+func (f *gzipFile) Close() error {
+	return errors.Join(f.Reader.Close(), f.file.Close())
+}
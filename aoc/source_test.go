@@ -0,0 +1,259 @@
+package aoc
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var testExamples = fstest.MapFS{
+	"examples/1.txt":    {Data: []byte("example one\n")},
+	"examples/1.golden": {Data: []byte("# connects 10 pairs\nconnections = 10\n\n1: 40\n2: 25272\n")},
+	"examples/2.txt":    {Data: []byte("example two\n")},
+	"examples/3.golden": {Data: []byte("1: 6\n")},
+	"examples/4.golden": {Data: []byte("  connections=10 \nlimit = -2\n1: 3\n")},
+	"examples/5.golden": {Data: []byte("= 10\n")},
+	"examples/6.golden": {Data: []byte("connections = ten\n")},
+	"examples/7.golden": {Data: []byte("unknown = 1\n")},
+}
+
+// writeGzip writes data gzipped to a file in dir and returns its path
+func writeGzip(t *testing.T, dir, data string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "input.txt.gz")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := gzip.NewWriter(f)
+	if _, err := zw.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(plain, []byte("on disk\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	gz := writeGzip(t, dir, "compressed\n")
+	notGzip := filepath.Join(dir, "plain.gz")
+	if err := os.WriteFile(notGzip, []byte("not gzip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdin := filepath.Join(dir, "stdin")
+	if err := os.WriteFile(stdin, []byte("from stdin\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	origStdin := os.Stdin
+	os.Stdin = f
+	defer func() { os.Stdin = origStdin }()
+
+	tests := []struct {
+		name     string
+		examples fs.FS
+		want     string
+		err      error  // checked with errors.Is if set
+		errMsg   string // contained in the error if set
+	}{
+		{name: "-", want: "from stdin\n"},
+		{name: "example:1", examples: testExamples, want: "example one\n"},
+		{name: "example:2", examples: testExamples, want: "example two\n"},
+		{name: plain, want: "on disk\n"},
+		{name: gz, want: "compressed\n"},
+		{name: "example:9", examples: testExamples, err: fs.ErrNotExist},
+		{name: "example:", examples: testExamples, errMsg: `invalid example name ""`},
+		{name: "example:../1", examples: testExamples, errMsg: "invalid example name"},
+		{name: `example:..\1`, examples: testExamples, errMsg: "invalid example name"},
+		{name: "example:1", errMsg: "no examples are embedded"},
+		{name: filepath.Join(dir, "missing.txt"), err: fs.ErrNotExist},
+		{name: notGzip, errMsg: "failed to decompress"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := Open(test.name, test.examples)
+			if test.err != nil || test.errMsg != "" {
+				if err == nil {
+					r.Close()
+					t.Fatal("Open() succeeded, want an error")
+				}
+				if test.err != nil && !errors.Is(err, test.err) {
+					t.Errorf("Open() error = %v, want %v", err, test.err)
+				}
+				if !strings.Contains(err.Error(), test.errMsg) {
+					t.Errorf("Open() error = %q, want it to contain %q", err, test.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(data); got != test.want {
+				t.Errorf("read %q, want %q", got, test.want)
+			}
+
+			if err := r.Close(); err != nil {
+				t.Errorf("Close() = %v", err)
+			}
+		})
+	}
+}
+
+func TestOpenGzipClosesFile(t *testing.T) {
+	r, err := Open(writeGzip(t, t.TempDir(), "compressed\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	// the file underneath was closed by the first Close
+	if err := r.Close(); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("second Close() = %v, want %v", err, os.ErrClosed)
+	}
+}
+
+func TestOpenStdinIsNotClosed(t *testing.T) {
+	r, err := Open(StdinName, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stdin.Stat(); err != nil {
+		t.Errorf("stdin after Close(): %v", err)
+	}
+}
+
+func TestExampleSettings(t *testing.T) {
+	tests := []struct {
+		id       string
+		examples fs.FS
+		want     Settings
+		errMsg   string
+	}{
+		{id: "1", examples: testExamples, want: Settings{"connections": 10}},
+		{id: "3", examples: testExamples, want: Settings{}},
+		{id: "4", examples: testExamples, want: Settings{"connections": 10, "limit": -2}},
+		{id: "5", examples: testExamples, errMsg: `examples/5.golden:1: expected "<name> = <integer>", got "= 10"`},
+		{id: "6", examples: testExamples, errMsg: `examples/6.golden:1: expected "<name> = <integer>", got "connections = ten"`},
+		{id: "2", examples: testExamples},
+		{id: "1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			got, err := ExampleSettings(test.examples, test.id)
+			if test.errMsg != "" {
+				if err == nil || err.Error() != test.errMsg {
+					t.Errorf("ExampleSettings() error = %v, want %q", err, test.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ExampleSettings() = %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+// configurableSolver records the settings it is configured with, accepting
+// only connections
+type configurableSolver struct {
+	settings Settings
+}
+
+func (s *configurableSolver) Configure(settings Settings) error {
+	for name := range settings {
+		if name != "connections" {
+			return errors.New("unknown setting " + name)
+		}
+	}
+	s.settings = settings
+	return nil
+}
+
+func (s *configurableSolver) Parse(io.Reader) error {
+	return nil
+}
+
+func (s *configurableSolver) PartOne(context.Context) (int, error) {
+	return 0, nil
+}
+
+func (s *configurableSolver) PartTwo(context.Context) (int, error) {
+	return 0, nil
+}
+
+func TestConfigure(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   Settings
+		errMsg string
+	}{
+		{name: "example:1", want: Settings{"connections": 10}},
+		{name: "example:3"},
+		{name: "example:2"},
+		{name: "input.txt"},
+		{name: "-"},
+		{name: "example:6", errMsg: "expected \"<name> = <integer>\""},
+		{name: "example:7", errMsg: "unknown setting unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &configurableSolver{}
+			err := Configure(s, test.name, testExamples)
+			if test.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), test.errMsg) {
+					t.Errorf("Configure() error = %v, want it to contain %q", err, test.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(s.settings, test.want) {
+				t.Errorf("configured with %#v, want %#v", s.settings, test.want)
+			}
+		})
+	}
+}
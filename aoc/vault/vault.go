@@ -0,0 +1,168 @@
+// Package vault encrypts puzzle inputs so they can be committed without
+// publishing them.
+//
+// Inputs are sealed with AES-256-GCM under a key derived from either a
+// passphrase, with PBKDF2, or the contents of a key file, with HKDF. Every
+// blob carries its own random salt and nonce and is bound to a label, such as
+// the input's path, so blobs can't be swapped between days unnoticed.
+package vault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Ext is appended to the name of an input to name its encrypted blob.
+const Ext = ".vault"
+
+const (
+	magic   = "aocvault"
+	version = 1
+
+	saltSize  = 16
+	nonceSize = 12
+	keySize   = 32
+
+	headerSize = len(magic) + 2 + saltSize + nonceSize
+
+	// pbkdf2Iterations follows the OWASP recommendation for PBKDF2-SHA256
+	pbkdf2Iterations = 600_000
+
+	// minKeyFileSize is the least number of bytes accepted from a key file
+	minKeyFileSize = 16
+)
+
+// how a blob's key is derived, recorded in its header
+const (
+	kdfPassphrase byte = 1
+	kdfKeyFile    byte = 2
+)
+
+// ErrDecrypt is returned when a blob can't be opened, either because the key
+// is wrong or the blob has been tampered with.
+var ErrDecrypt = errors.New("vault: wrong key or corrupted blob")
+
+// Key is the secret blobs are sealed with.
+type Key struct {
+	secret []byte
+	kdf    byte
+}
+
+// Passphrase returns a key derived from a passphrase.
+func Passphrase(passphrase string) (Key, error) {
+	if passphrase == "" {
+		return Key{}, errors.New("vault: empty passphrase")
+	}
+	return Key{secret: []byte(passphrase), kdf: kdfPassphrase}, nil
+}
+
+// KeyFile returns a key derived from the contents of a key file. Surrounding
+// whitespace is ignored so that hex encoded keys can end in a newline.
+func KeyFile(data []byte) (Key, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) < minKeyFileSize {
+		return Key{}, fmt.Errorf("vault: key file must hold at least %d bytes", minKeyFileSize)
+	}
+	return Key{secret: data, kdf: kdfKeyFile}, nil
+}
+
+// GenerateKeyFile returns the contents of a new random key file.
+func GenerateKeyFile() []byte {
+	return []byte(hex.EncodeToString(randomBytes(keySize)) + "\n")
+}
+
+// Encrypt seals plaintext under key, binding it to label.
+func Encrypt(key Key, plaintext []byte, label string) ([]byte, error) {
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = append(header, version, key.kdf)
+	header = append(header, randomBytes(saltSize)...)
+	header = append(header, randomBytes(nonceSize)...)
+
+	aead, err := key.aead(header)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := header[headerSize-nonceSize:]
+	return aead.Seal(header, nonce, plaintext, additionalData(header, label)), nil
+}
+
+// Decrypt opens a blob sealed by Encrypt with the same key and label.
+func Decrypt(key Key, blob []byte, label string) ([]byte, error) {
+	if len(blob) < headerSize || string(blob[:len(magic)]) != magic {
+		return nil, errors.New("vault: not a vault blob")
+	}
+
+	header := blob[:headerSize]
+	if v := header[len(magic)]; v != version {
+		return nil, fmt.Errorf("vault: unsupported version %d", v)
+	}
+
+	if kdf := header[len(magic)+1]; key.kdf != 0 && kdf != key.kdf {
+		if kdf == kdfKeyFile {
+			return nil, errors.New("vault: blob was sealed with a key file, not a passphrase")
+		}
+		return nil, errors.New("vault: blob was sealed with a passphrase, not a key file")
+	}
+
+	aead, err := key.aead(header)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := header[headerSize-nonceSize:]
+	plaintext, err := aead.Open(nil, nonce, blob[headerSize:], additionalData(header, label))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+// aead derives the blob key from the salt in header
+func (k Key) aead(header []byte) (cipher.AEAD, error) {
+	salt := header[len(magic)+2 : len(magic)+2+saltSize]
+
+	var derived []byte
+	var err error
+	switch k.kdf {
+	case kdfPassphrase:
+		derived, err = pbkdf2.Key(sha256.New, string(k.secret), salt, pbkdf2Iterations, keySize)
+	case kdfKeyFile:
+		derived, err = hkdf.Key(sha256.New, k.secret, salt, "aoc vault", keySize)
+	default:
+		return nil, errors.New("vault: key is not set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// additionalData authenticates the header and label along with the
+// ciphertext
+func additionalData(header []byte, label string) []byte {
+	return append(bytes.Clone(header), label...)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	// crypto/rand.Read never fails on supported platforms
+	rand.Read(b)
+	return b
+}
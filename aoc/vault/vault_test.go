@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+const label = "day_01/input.txt"
+
+var plaintext = []byte("L68\nL30\nR48\n")
+
+func keys(t *testing.T) map[string]Key {
+	t.Helper()
+
+	passphrase, err := Passphrase("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile, err := KeyFile(GenerateKeyFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]Key{"passphrase": passphrase, "key file": keyFile}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for name, key := range keys(t) {
+		t.Run(name, func(t *testing.T) {
+			blob, err := Encrypt(key, plaintext, label)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if bytes.Contains(blob, plaintext) {
+				t.Error("blob contains the plaintext")
+			}
+
+			got, err := Decrypt(key, blob, label)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, plaintext) {
+				t.Errorf("Decrypt() = %q, want %q", got, plaintext)
+			}
+		})
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key, err := KeyFile([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blob, err := Encrypt(key, plaintext, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, _ := KeyFile([]byte("fedcba9876543210fedcba9876543210"))
+	passphrase, _ := Passphrase("hunter2")
+
+	tampered := bytes.Clone(blob)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name  string
+		key   Key
+		blob  []byte
+		label string
+		isErr error
+	}{
+		{name: "wrong key", key: otherKey, blob: blob, label: label, isErr: ErrDecrypt},
+		{name: "wrong label", key: key, blob: blob, label: "day_02/input.txt", isErr: ErrDecrypt},
+		{name: "tampered", key: key, blob: tampered, label: label, isErr: ErrDecrypt},
+		{name: "passphrase for key file blob", key: passphrase, blob: blob, label: label},
+		{name: "not a blob", key: key, blob: plaintext, label: label},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decrypt(tt.key, tt.blob, tt.label)
+			if err == nil {
+				t.Fatal("Decrypt() succeeded")
+			}
+
+			if tt.isErr != nil && !errors.Is(err, tt.isErr) {
+				t.Errorf("err = %v, want %v", err, tt.isErr)
+			}
+		})
+	}
+}
+
+func TestKeyFileTooShort(t *testing.T) {
+	if _, err := KeyFile([]byte("short\n")); err == nil {
+		t.Error("KeyFile() accepted a 5 byte key")
+	}
+}
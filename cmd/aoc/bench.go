@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// benchBaselineFile is the default baseline location relative to the root
+const benchBaselineFile = "bench.json"
+
+// the stages of a day that are benchmarked, in the order they are reported
+var benchStages = []string{"parse", "part1", "part2"}
+
+type benchStat struct {
+	NsPerOp     int64  `json:"ns_per_op"`
+	BytesPerOp  uint64 `json:"bytes_per_op"`
+	AllocsPerOp uint64 `json:"allocs_per_op"`
+}
+
+type dayBench struct {
+	Input  string               `json:"input"` // input hash
+	Stages map[string]benchStat `json:"stages"`
+}
+
+// benchBaseline maps day directories, such as day_05 or 2024/day_05, to
+// their benchmark results
+type benchBaseline map[string]dayBench
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc bench [flags] [day|all]")
+		fs.PrintDefaults()
+	}
+
+	var opts options
+	opts.register(fs)
+	n := fs.Int("n", 10, "number of times each stage is run")
+	baselinePath := fs.String("baseline", "", "baseline file (default <root>/"+benchBaselineFile+")")
+	save := fs.Bool("save", false, "save the results as the new baseline")
+	threshold := fs.Float64("threshold", 10, "percentage increase over the baseline reported as a regression")
+	fs.Parse(args)
+
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return errors.New("expected at most one day")
+	}
+
+	if *n < 1 {
+		return errors.New("-n must be at least 1")
+	}
+
+	if *baselinePath == "" {
+		*baselinePath = filepath.Join(opts.root, benchBaselineFile)
+	}
+
+	arg := "all"
+	if fs.NArg() == 1 {
+		arg = fs.Arg(0)
+	}
+
+	days, err := selectDays(opts.year, arg)
+	if err != nil {
+		return err
+	}
+
+	baseline, err := loadBenchBaseline(*baselinePath)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "day\tstage\tns/op\tB/op\tallocs/op\tvs baseline\t")
+
+	var failed, regressions int
+	for _, day := range days {
+		bench, err := benchDay(&opts, day, *n)
+		if err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t%s\t\t\t\t\t\n", day, err)
+			continue
+		}
+
+		key := filepath.ToSlash(day.Dir())
+		prev, hasPrev := baseline[key]
+		if hasPrev && prev.Input != bench.Input {
+			// timings against a different input aren't comparable
+			hasPrev = false
+		}
+
+		for _, stage := range benchStages {
+			stat, ok := bench.Stages[stage]
+			if !ok {
+				continue
+			}
+
+			comparison := "-"
+			if old, ok := prev.Stages[stage]; hasPrev && ok {
+				var regressed bool
+				comparison, regressed = compareBench(old, stat, *threshold)
+				if regressed {
+					regressions++
+				}
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t\n",
+				day, stage, stat.NsPerOp, stat.BytesPerOp, stat.AllocsPerOp, comparison)
+		}
+
+		baseline[key] = bench
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if *save {
+		if err := baseline.save(*baselinePath); err != nil {
+			return err
+		}
+		fmt.Printf("\nsaved baseline to %s\n", *baselinePath)
+	}
+
+	switch {
+	case failed > 0 && regressions > 0:
+		return fmt.Errorf("%s failed, %s regressed by more than %g%%", plural(failed, "day"), plural(regressions, "stage"), *threshold)
+	case failed > 0:
+		return fmt.Errorf("%s failed", plural(failed, "day"))
+	case regressions > 0:
+		return fmt.Errorf("%s regressed by more than %g%%", plural(regressions, "stage"), *threshold)
+	default:
+		return nil
+	}
+}
+
+// benchDay runs the parse and both parts of day n times each
+func benchDay(opts *options, day aoc.Day, n int) (dayBench, error) {
+	name := opts.inputName(day)
+	data, err := opts.readInput(day)
+	if err != nil {
+		return dayBench{}, err
+	}
+
+	bench := dayBench{Input: aoc.HashInput(data), Stages: make(map[string]benchStat)}
+
+	bench.Stages["parse"], err = measure(n, func() error {
+		solver, err := opts.newSolver(day)
+		if err != nil {
+			return err
+		}
+		return opts.limit("parse", func(context.Context) (int, error) {
+			return 0, solver.Parse(bytes.NewReader(data))
+		})
+	})
+	if err != nil {
+		return dayBench{}, aoc.WithFile(err, name)
+	}
+
+	solver, err := opts.newSolver(day)
+	if err != nil {
+		return dayBench{}, err
+	}
+	if err := solver.Parse(bytes.NewReader(data)); err != nil {
+		return dayBench{}, aoc.WithFile(err, name)
+	}
+
+	for i, part := range []func(context.Context) (int, error){solver.PartOne, solver.PartTwo} {
+		stat, err := measure(n, func() error {
+			return opts.limit(strconv.Itoa(i+1), part)
+		})
+		if errors.Is(err, aoc.ErrNoPart) {
+			continue
+		}
+		if err != nil {
+			return dayBench{}, fmt.Errorf("part %d: %w", i+1, err)
+		}
+
+		bench.Stages[benchStages[i+1]] = stat
+	}
+
+	return bench, nil
+}
+
+// limit runs a stage of a day within the timeout of o, if there is one, see
+// runPart. Without a timeout the stage runs on the calling goroutine so that
+// it is measured as it is.
+func (o *options) limit(stage string, fn func(context.Context) (int, error)) error {
+	if o.timeout == 0 {
+		_, err := fn(context.Background())
+		return err
+	}
+
+	_, err := runPart(context.Background(), o.timeout, stage, fn)
+	return err
+}
+
+// plural formats a count of things such as "1 day" or "2 days"
+func plural(n int, thing string) string {
+	if n == 1 {
+		return "1 " + thing
+	}
+	return fmt.Sprintf("%d %ss", n, thing)
+}
+
+// measure runs fn n times and reports the average cost of a single run
+func measure(n int, fn func() error) (benchStat, error) {
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	for range n {
+		if err := fn(); err != nil {
+			return benchStat{}, err
+		}
+	}
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return benchStat{
+		NsPerOp:     elapsed.Nanoseconds() / int64(n),
+		BytesPerOp:  (after.TotalAlloc - before.TotalAlloc) / uint64(n),
+		AllocsPerOp: (after.Mallocs - before.Mallocs) / uint64(n),
+	}, nil
+}
+
+// compareBench describes the change in ns/op from old to curr and reports
+// whether any metric grew by more than threshold percent
+func compareBench(old, curr benchStat, threshold float64) (string, bool) {
+	regressed := change(float64(old.NsPerOp), float64(curr.NsPerOp)) > threshold ||
+		change(float64(old.BytesPerOp), float64(curr.BytesPerOp)) > threshold ||
+		change(float64(old.AllocsPerOp), float64(curr.AllocsPerOp)) > threshold
+
+	desc := fmt.Sprintf("%+.1f%%", change(float64(old.NsPerOp), float64(curr.NsPerOp)))
+	if regressed {
+		desc += " REGRESSION"
+	}
+
+	return desc, regressed
+}
+
+// change returns the percentage change from old to curr
+func change(old, curr float64) float64 {
+	if old == 0 {
+		if curr == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (curr - old) / old * 100
+}
+
+func loadBenchBaseline(path string) (benchBaseline, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(benchBaseline), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := make(benchBaseline)
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return baseline, nil
+}
+
+func (b benchBaseline) save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// failingSolver rejects every input
+type failingSolver struct{}
+
+func (failingSolver) Parse(io.Reader) error {
+	return errors.New("bad input")
+}
+
+func (failingSolver) PartOne(context.Context) (int, error) {
+	return 0, nil
+}
+
+func (failingSolver) PartTwo(context.Context) (int, error) {
+	return 0, nil
+}
+
+func init() {
+	aoc.RegisterYear(testYear, 2, nil, func() aoc.Solver { return failingSolver{} })
+}
+
+func TestBenchFailingDay(t *testing.T) {
+	root := t.TempDir()
+	input := filepath.Join(root, "input.txt")
+	if err := os.WriteFile(input, []byte("1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// day 1 solves the input and day 2 fails on it
+	var err error
+	capture(t, func() {
+		err = benchCommand([]string{"-root", root, "-year", fmt.Sprint(testYear), "-input", input, "-n", "1", "all"})
+	})
+
+	if err == nil || err.Error() != "1 day failed" {
+		t.Errorf("benchCommand() = %v, want 1 day failed", err)
+	}
+}
+
+func TestBenchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	// both parts ignore their context and take 100ms
+	day := testDay(3, func() aoc.Solver {
+		return funcSolver{partOne: func(ctx context.Context) (int, error) {
+			select {
+			case <-release:
+			case <-time.After(100 * time.Millisecond):
+			}
+			return 1, nil
+		}}
+	})
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		wantErr string // empty if the day is benchmarked
+	}{
+		{name: "no timeout"},
+		{name: "time to spare", timeout: time.Second},
+		{name: "timed out", timeout: 10 * time.Millisecond, wantErr: "part 1: timed out after 10ms"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := testOptions(t)
+			opts.timeout = test.timeout
+
+			bench, err := benchDay(opts, day, 1)
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Errorf("benchDay() = %v, want %s", err, test.wantErr)
+				}
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("benchDay() = %v, want a timeout", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, stage := range benchStages {
+				if _, ok := bench.Stages[stage]; !ok {
+					t.Errorf("stage %s wasn't measured", stage)
+				}
+			}
+		})
+	}
+}
+
+func TestPlural(t *testing.T) {
+	tests := map[int]string{0: "0 days", 1: "1 day", 2: "2 days"}
+	for n, want := range tests {
+		if got := plural(n, "day"); got != want {
+			t.Errorf("plural(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
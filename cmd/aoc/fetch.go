@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc fetch [flags] <day> [year]")
+		fs.PrintDefaults()
+	}
+
+	var copts clientOptions
+	copts.register(fs)
+	root := fs.String("root", ".", "repository root holding the day_XX directories")
+	force := fs.Bool("force", false, "download the input even if it has already been downloaded")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return errors.New("expected a day and an optional year")
+	}
+
+	day, year, err := parseDayYear(fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(*root, aoc.DayDir(year, day), "input.txt")
+	if _, err := os.Stat(dest); err == nil && !*force {
+		return fmt.Errorf("%s already exists, refusing to download it again", dest)
+	}
+
+	c, err := copts.newClient(*root)
+	if err != nil {
+		return err
+	}
+
+	input, err := c.Input(context.Background(), year, day)
+	if err != nil {
+		return fmt.Errorf("failed to fetch input for %d day %d: %w", year, day, err)
+	}
+
+	if err := writeFileAtomic(dest, input); err != nil {
+		return err
+	}
+
+	fmt.Printf("saved input for %d day %d to %s\n", year, day, dest)
+	return nil
+}
+
+// parseDayYear parses a day argument and an optional year argument
+func parseDayYear(rawDay, rawYear string) (day, year int, err error) {
+	day, err = strconv.Atoi(rawDay)
+	if err != nil || day < 1 || day > 25 {
+		return 0, 0, fmt.Errorf("invalid day %q", rawDay)
+	}
+
+	if rawYear == "" {
+		return day, aoc.DefaultYear, nil
+	}
+
+	year, err = strconv.Atoi(rawYear)
+	if err != nil || year < 2015 {
+		return 0, 0, fmt.Errorf("invalid year %q", rawYear)
+	}
+
+	return day, year, nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it
+// into place so that a failed write never leaves a truncated file behind
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// outputFormats are the values accepted by the run command's -format flag
+var outputFormats = []string{"text", "json", "tsv"}
+
+// row is a line of the run command's output, either a solved part or a
+// day whose input failed to parse
+type row struct {
+	Year      int           `json:"year"`
+	Day       int           `json:"day"`
+	Part      int           `json:"part,omitempty"` // 0 when the input failed to parse
+	Answer    *int          `json:"answer,omitempty"`
+	InputHash string        `json:"input_hash,omitempty"` // see aoc.HashInput
+	ParseTime time.Duration `json:"parse_ns"`
+	SolveTime time.Duration `json:"solve_ns"`
+	Status    string        `json:"status"` // ok, error or timeout
+	Error     string        `json:"error,omitempty"`
+}
+
+// rowWriter renders rows in one of the outputFormats
+type rowWriter interface {
+	write(r row) error
+	// flush writes anything buffered once every row has been written
+	flush() error
+}
+
+func newRowWriter(w io.Writer, format string) (rowWriter, error) {
+	switch format {
+	case "text":
+		return &textWriter{w: w}, nil
+	case "json":
+		return &jsonWriter{w: w, rows: []row{}}, nil
+	case "tsv":
+		return &tsvWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("invalid format %q, expected one of %s", format, strings.Join(outputFormats, ", "))
+	}
+}
+
+func (r row) label() string {
+	day := aoc.Day{Year: r.Year, Day: r.Day}
+	if r.Part == 0 {
+		return day.String()
+	}
+	return fmt.Sprintf("%s part %d", day, r.Part)
+}
+
+type textWriter struct {
+	w io.Writer
+}
+
+func (tw *textWriter) write(r row) error {
+	if r.Error != "" {
+		_, err := fmt.Fprintf(tw.w, "%s: %s\n", r.label(), r.Error)
+		return err
+	}
+
+	_, err := fmt.Fprintf(tw.w, "%s: %d (parse %s, solve %s)\n",
+		r.label(), *r.Answer, roundDuration(r.ParseTime), roundDuration(r.SolveTime))
+	return err
+}
+
+func (tw *textWriter) flush() error {
+	return nil
+}
+
+// jsonWriter writes every row as a single JSON array
+type jsonWriter struct {
+	w    io.Writer
+	rows []row
+}
+
+func (jw *jsonWriter) write(r row) error {
+	jw.rows = append(jw.rows, r)
+	return nil
+}
+
+func (jw *jsonWriter) flush() error {
+	enc := json.NewEncoder(jw.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jw.rows)
+}
+
+// tsvWriter writes a header followed by a tab separated line per row, with
+// durations in nanoseconds
+type tsvWriter struct {
+	w           io.Writer
+	wroteHeader bool
+}
+
+func (tw *tsvWriter) write(r row) error {
+	if !tw.wroteHeader {
+		if _, err := fmt.Fprintln(tw.w, "year\tday\tpart\tanswer\tinput_hash\tparse_ns\tsolve_ns\tstatus\terror"); err != nil {
+			return err
+		}
+		tw.wroteHeader = true
+	}
+
+	var part, answer string
+	if r.Part != 0 {
+		part = fmt.Sprint(r.Part)
+	}
+	if r.Answer != nil {
+		answer = aoc.FormatAnswer(*r.Answer)
+	}
+
+	// errors may span several lines, such as those of aoc.ParseError
+	errText := strings.NewReplacer("\t", " ", "\n", " ").Replace(r.Error)
+
+	_, err := fmt.Fprintf(tw.w, "%d\t%d\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n",
+		r.Year, r.Day, part, answer, r.InputHash, r.ParseTime.Nanoseconds(), r.SolveTime.Nanoseconds(), r.Status, errText)
+	return err
+}
+
+func (tw *tsvWriter) flush() error {
+	return nil
+}
+
+// roundDuration trims a duration to a readable precision
+func roundDuration(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond)
+	case d >= time.Microsecond:
+		return d.Round(time.Microsecond)
+	default:
+		return d
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func genCommand(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc gen [flags] <day>")
+		fs.PrintDefaults()
+	}
+
+	year := fs.Int("year", aoc.DefaultYear, "event the day belongs to")
+	seed := fs.Uint64("seed", 0, "seed of the generated input, the same as the test harness uses (default a random seed, printed to stderr)")
+	size := fs.Int("size", 100, "size of the generated input, usually the number of lines or items")
+	out := fs.String("o", "", "write the input to this file instead of stdout")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("expected a day")
+	}
+
+	n, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid day %q", fs.Arg(0))
+	}
+
+	if *size < 1 {
+		return fmt.Errorf("invalid size %d, expected at least 1", *size)
+	}
+
+	gen, ok := aoc.LookupGenerator(*year, n)
+	if !ok {
+		return fmt.Errorf("%d day %d has no input generator", *year, n)
+	}
+
+	// 0 is a seed like any other, so an input the test harness generated
+	// with it can be written again, and only leaving the flag out picks one
+	seeded := false
+	fs.Visit(func(f *flag.Flag) { seeded = seeded || f.Name == "seed" })
+
+	if !seeded {
+		*seed = rand.Uint64()
+		fmt.Fprintf(os.Stderr, "seed %d\n", *seed)
+	}
+
+	var buf bytes.Buffer
+	if err := gen(&buf, newRand(*seed), *size); err != nil {
+		return err
+	}
+
+	// a generator that writes something its own solver rejects is a bug
+	if day, ok := aoc.Lookup(*year, n); ok {
+		if err := day.New().Parse(bytes.NewReader(buf.Bytes())); err != nil {
+			return fmt.Errorf("generated %s input doesn't parse (seed %d, size %d): %w", day, *seed, *size, err)
+		}
+	}
+
+	if *out == "" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return writeFileAtomic(*out, buf.Bytes())
+}
+
+// newRand returns the generator source for a seed
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func TestGenSeed(t *testing.T) {
+	gen, ok := aoc.LookupGenerator(aoc.DefaultYear, 1)
+	if !ok {
+		t.Fatal("day 1 has no generator")
+	}
+
+	// what aoctest.Generate writes for a seed
+	generated := func(seed uint64) []byte {
+		var buf bytes.Buffer
+		if err := gen(&buf, newRand(seed), 10); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		seed   uint64 // of the input written, read from stderr if random
+		random bool
+	}{
+		{name: "zero", args: []string{"-seed", "0"}, seed: 0},
+		{name: "seed", args: []string{"-seed", "42"}, seed: 42},
+		{name: "random", random: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "input.txt")
+			args := append(test.args, "-size", "10", "-o", out, "1")
+
+			var err error
+			_, stderr := capture(t, func() {
+				err = genCommand(args)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			seed := test.seed
+			if test.random {
+				raw, ok := strings.CutPrefix(strings.TrimSpace(stderr), "seed ")
+				if seed, err = strconv.ParseUint(raw, 10, 64); !ok || err != nil {
+					t.Fatalf("stderr = %q, want the random seed", stderr)
+				}
+			} else if stderr != "" {
+				t.Errorf("stderr = %q, want nothing for a given seed", stderr)
+			}
+
+			got, err := os.ReadFile(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, generated(seed)) {
+				t.Errorf("wrote a different input than seed %d generates:\n%s", seed, got)
+			}
+		})
+	}
+}
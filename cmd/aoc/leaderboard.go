@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/leaderboard"
+)
+
+// leaderboardCacheTTL is how long a downloaded leaderboard is reused, the
+// site asks not to fetch a leaderboard more than once every 15 minutes
+const leaderboardCacheTTL = 15 * time.Minute
+
+func leaderboardCommand(args []string) error {
+	fs := flag.NewFlagSet("leaderboard", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc leaderboard [flags] <id>")
+		fmt.Fprintln(fs.Output(), "       aoc leaderboard [flags] -file <path>")
+		fs.PrintDefaults()
+	}
+
+	var copts clientOptions
+	copts.register(fs)
+	root := fs.String("root", ".", "repository root holding the .env file and the leaderboard cache")
+	year := fs.Int("year", aoc.DefaultYear, "event of the leaderboard")
+	file := fs.String("file", "", "read the leaderboard JSON from a file instead of the site")
+	day := fs.Int("day", 0, "only show the star times of this day (default every day with stars)")
+	fs.Parse(args)
+
+	if *day < 0 || *day > 25 {
+		return fmt.Errorf("invalid day %d", *day)
+	}
+
+	var data []byte
+	var err error
+	switch {
+	case *file != "" && fs.NArg() == 0:
+		data, err = os.ReadFile(*file)
+	case *file == "" && fs.NArg() == 1:
+		id, convErr := strconv.Atoi(fs.Arg(0))
+		if convErr != nil {
+			return fmt.Errorf("invalid leaderboard id %q", fs.Arg(0))
+		}
+		data, err = fetchLeaderboard(&copts, *root, *year, id)
+	default:
+		fs.Usage()
+		return errors.New("expected either a leaderboard id or -file")
+	}
+	if err != nil {
+		return err
+	}
+
+	l, err := leaderboard.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	days := l.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+
+	return leaderboard.Render(os.Stdout, l, days)
+}
+
+// fetchLeaderboard downloads a private leaderboard, reusing the copy cached
+// at root if it is recent enough
+func fetchLeaderboard(copts *clientOptions, root string, year, id int) ([]byte, error) {
+	cache := filepath.Join(root, fmt.Sprintf("leaderboard-%d-%d.json", year, id))
+	if info, err := os.Stat(cache); err == nil && time.Since(info.ModTime()) < leaderboardCacheTTL {
+		return os.ReadFile(cache)
+	}
+
+	c, err := copts.newClient(root)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Leaderboard(context.Background(), year, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboard %d for %d: %w", id, year, err)
+	}
+
+	if err := writeFileAtomic(cache, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
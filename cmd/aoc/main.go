@@ -0,0 +1,67 @@
+// Command aoc runs the registered Advent of Code solvers.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "run", usage: "run [flags] <day|all> [part]", run: runCommand},
+	{name: "verify", usage: "verify [flags] [day|all]", run: verifyCommand},
+	{name: "bench", usage: "bench [flags] [day|all]", run: benchCommand},
+	{name: "fetch", usage: "fetch [flags] <day> [year]", run: fetchCommand},
+	{name: "submit", usage: "submit [flags] <day> <part>", run: submitCommand},
+	{name: "new", usage: "new [flags] <day> [year]", run: newCommand},
+	{name: "puzzle", usage: "puzzle [flags] <day> [year]", run: puzzleCommand},
+	{name: "gen", usage: "gen [flags] <day>", run: genCommand},
+	{name: "leaderboard", usage: "leaderboard [flags] <id>", run: leaderboardCommand},
+	{name: "watch", usage: "watch [flags] <day>", run: watchCommand},
+	{name: "vault", usage: "vault encrypt|decrypt|keygen [flags] [day|all]", run: vaultCommand},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("aoc: ")
+
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	name, args := flag.Arg(0), flag.Args()[1:]
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+
+		if err := cmd.run(args); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	log.Printf("unknown command %q", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  aoc %s\n", cmd.usage)
+	}
+	fmt.Fprintln(os.Stderr, "\nrun 'aoc <command> -h' for the flags of a command")
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"text/template"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+var (
+	// dayDirPattern matches the directories of the days registered in days.go
+	dayDirPattern = regexp.MustCompile(`^day_\d{2}$`)
+	// yearDirPattern matches the directories holding the days of other years
+	yearDirPattern = regexp.MustCompile(`^\d{4}$`)
+)
+
+type scaffold struct {
+	Year    int
+	Day     int
+	Package string
+	// Default is set for days of aoc.DefaultYear, which register with
+	// aoc.Register rather than aoc.RegisterYear
+	Default bool
+	// Style is either "lines" or "string", for solvers that read their input
+	// with aoc.ReadLines and aoc.ReadString respectively
+	Style string
+}
+
+func newCommand(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc new [flags] <day> [year]")
+		fs.PrintDefaults()
+	}
+
+	root := fs.String("root", ".", "repository root holding the day_XX directories")
+	style := fs.String("style", "lines", "how the input is read, either lines (with aoc.ReadLines) or string (with aoc.ReadString)")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return errors.New("expected a day and an optional year")
+	}
+
+	if *style != "lines" && *style != "string" {
+		return fmt.Errorf("invalid style %q, expected lines or string", *style)
+	}
+
+	day, year, err := parseDayYear(fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(*root, aoc.DayDir(year, day))
+	if _, err := os.Stat(filepath.Join(dir, "main.go")); err == nil {
+		return fmt.Errorf("%s already exists", filepath.Join(dir, "main.go"))
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "examples"), 0o755); err != nil {
+		return err
+	}
+
+	data := scaffold{
+		Year:    year,
+		Day:     day,
+		Package: fmt.Sprintf("day%02d", day),
+		Default: year == aoc.DefaultYear,
+		Style:   *style,
+	}
+
+	files := []struct {
+		path     string
+		template string
+	}{
+		{path: filepath.Join(dir, "main.go"), template: "main.go.tmpl"},
+		{path: filepath.Join(dir, "main_test.go"), template: "main_test.go.tmpl"},
+	}
+
+	for _, file := range files {
+		if err := renderGo(file.path, file.template, data); err != nil {
+			return err
+		}
+	}
+
+	// the example is filled in by hand or by aoc puzzle, the golden file
+	// starts out empty so the tests pass until answers are added to it
+	for _, name := range []string{"1.txt", "1.golden"} {
+		path := filepath.Join(dir, "examples", name)
+		if _, err := os.Stat(path); err == nil {
+			continue
+		}
+
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			return err
+		}
+	}
+
+	if err := writeDaysFile(*root); err != nil {
+		return err
+	}
+
+	fmt.Printf("created %s\n", dir)
+	return nil
+}
+
+// writeDaysFile regenerates cmd/aoc/days.go to import every day under root,
+// both those at the top level and those under a year directory
+func writeDaysFile(root string) error {
+	dirs, err := findDayDirs(root, "")
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !yearDirPattern.MatchString(entry.Name()) {
+			continue
+		}
+
+		yearDirs, err := findDayDirs(root, entry.Name())
+		if err != nil {
+			return err
+		}
+		dirs = append(dirs, yearDirs...)
+	}
+	slices.Sort(dirs)
+
+	return renderGo(filepath.Join(root, "cmd", "aoc", "days.go"), "days.go.tmpl", dirs)
+}
+
+// findDayDirs returns the slash separated paths of the day directories with a
+// main.go directly under root/parent
+func findDayDirs(root, parent string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(root, parent))
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !dayDirPattern.MatchString(entry.Name()) {
+			continue
+		}
+
+		dir := path.Join(parent, entry.Name())
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(dir), "main.go")); err == nil {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs, nil
+}
+
+// renderGo executes the named template and writes the gofmt'd result to path
+func renderGo(path, name string, data any) error {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewScaffoldBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("vets a generated module")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not installed")
+	}
+
+	// a copy of the module with the shared packages but none of the days
+	root := t.TempDir()
+	for _, name := range []string{"go.mod", "aoc"} {
+		src := filepath.Join("..", "..", name)
+		info, err := os.Stat(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if info.IsDir() {
+			err = os.CopyFS(filepath.Join(root, name), os.DirFS(src))
+		} else {
+			var data []byte
+			if data, err = os.ReadFile(src); err == nil {
+				err = os.WriteFile(filepath.Join(root, name), data, 0o644)
+			}
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "cmd", "aoc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	scaffolds := [][]string{
+		{"3"},
+		{"-style", "string", "4"},
+		{"3", "2024"},
+		{"-style", "string", "4", "2024"},
+	}
+	for _, args := range scaffolds {
+		capture(t, func() {
+			err = newCommand(append([]string{"-root", root}, args...))
+		})
+		if err != nil {
+			t.Fatalf("new %v: %v", args, err)
+		}
+	}
+
+	vet := exec.Command(goTool, "vet", "./day_03", "./day_04", "./2024/day_03", "./2024/day_04", "./cmd/aoc")
+	vet.Dir = root
+	if out, err := vet.CombinedOutput(); err != nil {
+		t.Fatalf("go vet: %v\n%s", err, out)
+	}
+
+	days, err := os.ReadFile(filepath.Join(root, "cmd", "aoc", "days.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{"day_03", "day_04", "2024/day_03", "2024/day_04"} {
+		if want := `"github.com/ayo-awe/advent-of-code-2025/` + dir + `"`; !bytes.Contains(days, []byte(want)) {
+			t.Errorf("days.go doesn't import %s:\n%s", dir, days)
+		}
+	}
+}
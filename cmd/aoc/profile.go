@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// profileOptions are the flags that write profiles of a run. Solvers run with
+// pprof labels for their year, day and part (parse, 1 or 2), so a profile of
+// a whole run can be narrowed with e.g "go tool pprof -tagfocus day=08", and
+// every day is a task in the execution trace.
+type profileOptions struct {
+	cpu   string
+	mem   string
+	trace string
+}
+
+func (o *profileOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.cpu, "cpuprofile", "", "write a CPU profile to this file")
+	fs.StringVar(&o.mem, "memprofile", "", "write an allocation profile to this file when the run finishes")
+	fs.StringVar(&o.trace, "trace", "", "write an execution trace to this file")
+}
+
+// start begins the requested profiles and returns a function that stops them
+// and writes the memory profile
+func (o *profileOptions) start() (stop func() error, err error) {
+	var stops []func() error
+	stopAll := func() error {
+		var errs []error
+		for _, fn := range stops {
+			errs = append(errs, fn())
+		}
+		return errors.Join(errs...)
+	}
+
+	// stop whatever was started if a later profile fails to start, the error
+	// returns leave stop nil
+	defer func() {
+		if err != nil {
+			stopAll()
+		}
+	}()
+
+	if o.cpu != "" {
+		f, err := os.Create(o.cpu)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if o.trace != "" {
+		f, err := os.Create(o.trace)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if o.mem != "" {
+		path := o.mem
+		stops = append(stops, func() error {
+			f, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+
+			// allocation profiles don't record labels, they are best taken
+			// of a single day
+			runtime.GC()
+			if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		})
+	}
+
+	return stopAll, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileStartUnwritable(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing", "out.prof")
+	tests := []struct {
+		name string
+		opts profileOptions
+	}{
+		{"cpu", profileOptions{cpu: missing}},
+		{"trace", profileOptions{trace: missing}},
+		{"trace after cpu", profileOptions{cpu: filepath.Join(t.TempDir(), "cpu.prof"), trace: missing}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stop, err := tt.opts.start()
+			if err == nil {
+				stop()
+				t.Fatal("expected an error")
+			}
+			if stop != nil {
+				t.Error("stop should be nil on error")
+			}
+		})
+	}
+
+	// the CPU profile started before the trace failed must have been stopped
+	// so another can start
+	cpu := profileOptions{cpu: filepath.Join(t.TempDir(), "cpu.prof")}
+	stop, err := cpu.start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfileStartMemUnwritable(t *testing.T) {
+	opts := profileOptions{mem: filepath.Join(t.TempDir(), "missing", "mem.prof")}
+	stop, err := opts.start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("stop = %v, want a not exist error", err)
+	}
+}
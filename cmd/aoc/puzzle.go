@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/client"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/puzzle"
+)
+
+func puzzleCommand(args []string) error {
+	fs := flag.NewFlagSet("puzzle", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc puzzle [flags] <day> [year]")
+		fs.PrintDefaults()
+	}
+
+	var copts clientOptions
+	copts.register(fs)
+	root := fs.String("root", ".", "repository root holding the day_XX directories")
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return errors.New("expected a day and an optional year")
+	}
+
+	day, year, err := parseDayYear(fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	c, err := copts.newClient(*root)
+	if err != nil {
+		return err
+	}
+
+	return savePuzzle(c, *root, year, day)
+}
+
+// savePuzzle downloads the description of a day's puzzle into the day's
+// README.md and, unless it already has content, its first example into
+// examples/1.txt. Running it again after part one is solved adds part two.
+func savePuzzle(c *client.Client, root string, year, day int) error {
+	page, err := c.Puzzle(context.Background(), year, day)
+	if err != nil {
+		return fmt.Errorf("failed to fetch puzzle for %d day %d: %w", year, day, err)
+	}
+
+	pageURL := fmt.Sprintf("%s/%d/day/%d", strings.TrimSuffix(c.BaseURL, "/"), year, day)
+	p, err := puzzle.Parse(page, pageURL)
+	if err != nil {
+		return fmt.Errorf("%d day %d: %w", year, day, err)
+	}
+
+	dir := filepath.Join(root, aoc.DayDir(year, day))
+	readme := filepath.Join(dir, "README.md")
+	if err := writeFileAtomic(readme, []byte(p.Markdown())); err != nil {
+		return err
+	}
+	fmt.Printf("saved %d of 2 parts of %q to %s\n", len(p.Parts), p.Title, readme)
+
+	if p.Example == "" {
+		return nil
+	}
+
+	// never clobber an example that has been filled in by hand
+	example := filepath.Join(dir, "examples", "1.txt")
+	if existing, err := os.ReadFile(example); err == nil && len(strings.TrimSpace(string(existing))) > 0 {
+		return nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if err := writeFileAtomic(example, []byte(p.Example)); err != nil {
+		return err
+	}
+	fmt.Printf("saved example to %s\n", example)
+
+	return nil
+}
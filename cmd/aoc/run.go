@@ -0,0 +1,518 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime/pprof"
+	"runtime/trace"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/vault"
+)
+
+// options are the flags shared by every command that runs solvers
+type options struct {
+	root    string
+	year    int
+	input   string
+	timeout time.Duration
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	o.registerRealInput(fs)
+	fs.StringVar(&o.input, "input", "", "input name, - for stdin or example:N (default <root>/day_XX/input.txt)")
+}
+
+// registerRealInput registers every flag but -input, for commands that must
+// only ever solve the days' real inputs
+func (o *options) registerRealInput(fs *flag.FlagSet) {
+	fs.StringVar(&o.root, "root", ".", "repository root holding the day_XX directories")
+	fs.IntVar(&o.year, "year", aoc.DefaultYear, "event the days belong to, kept under <root>/<year> unless it is the default")
+	fs.DurationVar(&o.timeout, "timeout", 0, "time limit for parsing the input and for each part, 0 for none; parts that ignore it are abandoned but keep running")
+}
+
+func (o *options) answersPath() string {
+	return filepath.Join(o.root, aoc.AnswersFile)
+}
+
+func (o *options) inputName(day aoc.Day) string {
+	if o.input != "" {
+		return o.input
+	}
+	return filepath.Join(o.root, day.Dir(), "input.txt")
+}
+
+// newSolver returns a fresh solver for day, configured with the settings of
+// the input when it is an example
+func (o *options) newSolver(day aoc.Day) (aoc.Solver, error) {
+	solver := day.New()
+	if err := aoc.Configure(solver, o.inputName(day), day.Examples); err != nil {
+		return nil, err
+	}
+	return solver, nil
+}
+
+type result struct {
+	part    int
+	answer  int
+	err     error
+	elapsed time.Duration
+}
+
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc run [flags] <day|all> [part]")
+		fs.PrintDefaults()
+	}
+
+	var opts options
+	opts.register(fs)
+	format := fs.String("format", "text", "output format, one of "+strings.Join(outputFormats, ", "))
+	jobs := fs.Int("jobs", 1, "number of days solved concurrently, timings are less reliable above 1")
+	var profile profileOptions
+	profile.register(fs)
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		return errors.New("expected a day and an optional part")
+	}
+
+	if *jobs < 1 {
+		return errors.New("-jobs must be at least 1")
+	}
+
+	out, err := newRowWriter(os.Stdout, *format)
+	if err != nil {
+		return err
+	}
+
+	days, err := selectDays(opts.year, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	parts := []int{1, 2}
+	if fs.NArg() == 2 {
+		part, err := parsePart(fs.Arg(1))
+		if err != nil {
+			return err
+		}
+		parts = []int{part}
+	}
+
+	stopProfiles, err := profile.start()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stopProfiles(); err != nil {
+			log.Printf("failed to write profiles: %v", err)
+		}
+	}()
+
+	answers, err := aoc.LoadAnswers(opts.answersPath())
+	if err != nil {
+		return err
+	}
+
+	// interrupting stops the solvers that watch their context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var failed, timedOut int
+	count := func(err error) {
+		if errors.Is(err, context.DeadlineExceeded) {
+			timedOut++
+		} else {
+			failed++
+		}
+	}
+
+	for run, err := range solveAll(ctx, days, &opts, parts, *jobs) {
+		day := run.day
+		if warning := checkInputHash(answers, run); warning != "" {
+			log.Printf("warning: %s", warning)
+		}
+		if err != nil {
+			count(err)
+			r := row{
+				Year:      day.Year,
+				Day:       day.Day,
+				InputHash: run.hash,
+				ParseTime: run.parseTime,
+				Status:    status(err),
+				Error:     err.Error(),
+			}
+			if err := out.write(r); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, res := range run.results {
+			if errors.Is(res.err, aoc.ErrNoPart) {
+				continue
+			}
+
+			r := row{
+				Year:      day.Year,
+				Day:       day.Day,
+				Part:      res.part,
+				InputHash: run.hash,
+				ParseTime: run.parseTime,
+				SolveTime: res.elapsed,
+				Status:    status(res.err),
+			}
+			if res.err != nil {
+				r.Error = res.err.Error()
+				count(res.err)
+			} else {
+				r.Answer = &res.answer
+			}
+
+			if err := out.write(r); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := out.flush(); err != nil {
+		return err
+	}
+
+	switch {
+	case failed > 0 && timedOut > 0:
+		return fmt.Errorf("%d failed, %d timed out", failed, timedOut)
+	case failed > 0:
+		return fmt.Errorf("%d failed", failed)
+	case timedOut > 0:
+		return fmt.Errorf("%d timed out", timedOut)
+	}
+
+	return nil
+}
+
+// solveAll solves days on a pool of jobs workers, yielding the runs in the
+// order of days as soon as each one and those before it are done
+func solveAll(ctx context.Context, days []aoc.Day, opts *options, parts []int, jobs int) iter.Seq2[dayRun, error] {
+	return func(yield func(dayRun, error) bool) {
+		type outcome struct {
+			run dayRun
+			err error
+		}
+
+		outcomes := make([]chan outcome, len(days))
+		for i := range outcomes {
+			outcomes[i] = make(chan outcome, 1)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		work := make(chan int)
+		go func() {
+			defer close(work)
+			for i := range days {
+				select {
+				case work <- i:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		for range min(jobs, len(days)) {
+			go func() {
+				for i := range work {
+					// a day handed out just as the run was cancelled is
+					// never started
+					if err := ctx.Err(); err != nil {
+						outcomes[i] <- outcome{run: dayRun{day: days[i]}, err: err}
+						continue
+					}
+
+					run, err := solve(ctx, opts, days[i], parts)
+					outcomes[i] <- outcome{run: run, err: err}
+				}
+			}()
+		}
+
+		for i, day := range days {
+			var o outcome
+			select {
+			case o = <-outcomes[i]:
+			case <-ctx.Done():
+				o = outcome{run: dayRun{day: day}, err: ctx.Err()}
+			}
+
+			if !yield(o.run, o.err) {
+				return
+			}
+		}
+	}
+}
+
+// checkInputHash returns a warning if answers have been recorded for the day
+// but none for the input it was run against, which usually means the input
+// belongs to someone else or is the wrong file
+func checkInputHash(answers aoc.Answers, run dayRun) string {
+	if run.hash == "" || strings.HasPrefix(run.input, aoc.ExamplePrefix) {
+		return ""
+	}
+
+	hashes := answers.Hashes(run.day.Year, run.day.Day)
+	if len(hashes) == 0 || slices.Contains(hashes, run.hash) {
+		return ""
+	}
+
+	short := make([]string, len(hashes))
+	for i, hash := range hashes {
+		short[i] = shortHash(hash)
+	}
+
+	return fmt.Sprintf("%s: input %s has hash %s but the recorded answers are for %s, is it the right input?",
+		run.day, run.input, shortHash(run.hash), strings.Join(short, ", "))
+}
+
+// shortHash abbreviates an input hash for display
+func shortHash(hash string) string {
+	return hash[:min(len(hash), 12)]
+}
+
+// status summarises the outcome of solving a part for the row output
+func status(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "error"
+	}
+}
+
+// dayRun is the outcome of solving a day against a single input
+type dayRun struct {
+	day     aoc.Day
+	input   string // input name
+	hash    string // input hash, see aoc.HashInput
+	results []result
+
+	parseTime time.Duration
+}
+
+// solve parses the day's input and solves the given parts. Parsing and each
+// part are abandoned after the timeout in opts, if it is non-zero, see
+// runPart for what becomes of solvers that ignore it.
+func solve(ctx context.Context, opts *options, day aoc.Day, parts []int) (dayRun, error) {
+	name := opts.inputName(day)
+	run := dayRun{day: day, input: name}
+	timeout := opts.timeout
+
+	file, err := opts.openInput(day)
+	if err != nil {
+		return run, err
+	}
+	defer file.Close()
+
+	// the input is hashed as the solver reads it rather than read twice
+	hasher := aoc.NewInputHasher()
+	input := io.TeeReader(file, hasher)
+
+	// label everything the day does so profiles can be split by puzzle
+	ctx = pprof.WithLabels(ctx, pprof.Labels("year", strconv.Itoa(day.Year), "day", fmt.Sprintf("%02d", day.Day)))
+	ctx, task := trace.NewTask(ctx, day.String())
+	defer task.End()
+
+	solver, err := opts.newSolver(day)
+	if err != nil {
+		return run, err
+	}
+
+	start := time.Now()
+	_, err = runPart(ctx, timeout, "parse", func(context.Context) (int, error) {
+		return 0, solver.Parse(input)
+	})
+	run.parseTime = time.Since(start)
+	if err != nil {
+		return run, aoc.WithFile(err, name)
+	}
+
+	// hash whatever the solver left unread
+	if _, err := io.Copy(io.Discard, input); err != nil {
+		return run, err
+	}
+	run.hash = hasher.Sum()
+
+	run.results = make([]result, len(parts))
+	for i, part := range parts {
+		res := result{part: part}
+		start := time.Now()
+		if part == 1 {
+			res.answer, res.err = runPart(ctx, timeout, "1", solver.PartOne)
+		} else {
+			res.answer, res.err = runPart(ctx, timeout, "2", solver.PartTwo)
+		}
+		res.elapsed = time.Since(start)
+		run.results[i] = res
+	}
+
+	return run, nil
+}
+
+// readInput reads the day's input in full, see openInput.
+func (o *options) readInput(day aoc.Day) ([]byte, error) {
+	file, err := o.openInput(day)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// openInput opens the day's input. When the default input.txt is missing it
+// is decrypted from the blob written by aoc vault, if there is one.
+func (o *options) openInput(day aoc.Day) (io.ReadCloser, error) {
+	name := o.inputName(day)
+	file, err := aoc.Open(name, day.Examples)
+	if o.input != "" || !errors.Is(err, fs.ErrNotExist) {
+		return file, err
+	}
+
+	blob, blobErr := os.ReadFile(name + vault.Ext)
+	if errors.Is(blobErr, fs.ErrNotExist) {
+		return nil, err
+	}
+	if blobErr != nil {
+		return nil, blobErr
+	}
+
+	key, err := loadVaultKey(o.root, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s is missing and can't be decrypted: %w", name, err)
+	}
+
+	data, err := vault.Decrypt(key, blob, vaultLabel(day))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name+vault.Ext, err)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// timeoutError reports a part that didn't finish within its time limit
+type timeoutError struct {
+	limit time.Duration
+}
+
+func (e timeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s", e.limit)
+}
+
+func (e timeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// runPart calls fn with a context that expires after timeout, if it is
+// non-zero, labelling it as part for profiles. It returns as soon as ctx is
+// done without waiting for fn: a solver that doesn't watch its context keeps
+// running on its own goroutine, using CPU and memory, until it returns or the
+// process exits. Its answer is then discarded, but anything it prints still
+// shows up.
+func runPart(ctx context.Context, timeout time.Duration, part string, fn func(context.Context) (int, error)) (int, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	type outcome struct {
+		answer int
+		err    error
+	}
+
+	done := make(chan outcome, 1)
+	go pprof.Do(ctx, pprof.Labels("part", part), func(ctx context.Context) {
+		defer trace.StartRegion(ctx, "part "+part).End()
+
+		answer, err := protect(func() (int, error) { return fn(ctx) })
+		done <- outcome{answer: answer, err: err}
+	})
+
+	var o outcome
+	select {
+	case o = <-done:
+	case <-ctx.Done():
+		o.err = ctx.Err()
+	}
+
+	if errors.Is(o.err, context.DeadlineExceeded) && timeout > 0 {
+		return 0, timeoutError{limit: timeout}
+	}
+	return o.answer, o.err
+}
+
+// protect calls fn, turning a panic into an error so that one broken day
+// doesn't take down the rest of a run
+func protect(fn func() (int, error)) (answer int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return fn()
+}
+
+// selectDays resolves a day argument of year, either a day number or "all"
+func selectDays(year int, arg string) ([]aoc.Day, error) {
+	if arg == "all" {
+		var days []aoc.Day
+		for _, day := range aoc.Days() {
+			if day.Year == year {
+				days = append(days, day)
+			}
+		}
+
+		if len(days) == 0 {
+			return nil, fmt.Errorf("%d has no registered solvers", year)
+		}
+		return days, nil
+	}
+
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", arg)
+	}
+
+	day, ok := aoc.Lookup(year, n)
+	if !ok {
+		return nil, fmt.Errorf("%d day %d has no registered solver", year, n)
+	}
+
+	return []aoc.Day{day}, nil
+}
+
+func parsePart(arg string) (int, error) {
+	part, err := strconv.Atoi(arg)
+	if err != nil || (part != 1 && part != 2) {
+		return 0, fmt.Errorf("invalid part %q, expected 1 or 2", arg)
+	}
+	return part, nil
+}
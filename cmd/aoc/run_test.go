@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// testYear holds the days registered by the tests, away from the real ones
+const testYear = 1999
+
+// answerSolver answers part one without reading its input
+type answerSolver struct{}
+
+func (answerSolver) Parse(io.Reader) error {
+	return nil
+}
+
+func (answerSolver) PartOne(context.Context) (int, error) {
+	return 42, nil
+}
+
+func (answerSolver) PartTwo(context.Context) (int, error) {
+	return 0, aoc.ErrNoPart
+}
+
+func init() {
+	aoc.RegisterYear(testYear, 1, nil, func() aoc.Solver { return answerSolver{} })
+}
+
+// capture redirects stdout and stderr to files while fn runs and returns what
+// was written to each
+func capture(t *testing.T, fn func()) (stdout, stderr string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	files := make([]*os.File, 2)
+	for i, name := range []string{"stdout", "stderr"} {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		files[i] = f
+	}
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = files[0], files[1]
+	defer func() { os.Stdout, os.Stderr = origStdout, origStderr }()
+
+	fn()
+
+	out := make([]string, 2)
+	for i, f := range files {
+		data, err := os.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		out[i] = string(data)
+	}
+	return out[0], out[1]
+}
+
+func TestRunJSON(t *testing.T) {
+	root := t.TempDir()
+	input := filepath.Join(root, "input.txt")
+	if err := os.WriteFile(input, []byte("1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var err error
+	stdout, _ := capture(t, func() {
+		err = runCommand([]string{"-root", root, "-year", fmt.Sprint(testYear), "-input", input, "-format", "json", "1"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []row
+	if err := json.Unmarshal([]byte(stdout), &rows); err != nil {
+		t.Fatalf("stdout is not json: %v\n%s", err, stdout)
+	}
+	if len(rows) != 1 || rows[0].Answer == nil || *rows[0].Answer != 42 {
+		t.Fatalf("rows = %+v, want part 1 answering 42", rows)
+	}
+
+	// the solver reads none of the input, it is hashed all the same
+	if want := aoc.HashInput([]byte("1\n")); rows[0].InputHash != want {
+		t.Errorf("input hash = %s, want %s", rows[0].InputHash, want)
+	}
+}
+
+// testDay returns an unregistered day of testYear whose solver is made by
+// newSolver, so that tests of the run command's "all" don't pick it up
+func testDay(day int, newSolver func() aoc.Solver) aoc.Day {
+	return aoc.Day{Year: testYear, Day: day, New: newSolver}
+}
+
+// testOptions returns options reading a one line input written to a
+// temporary root
+func testOptions(t *testing.T) *options {
+	t.Helper()
+
+	root := t.TempDir()
+	input := filepath.Join(root, "input.txt")
+	if err := os.WriteFile(input, []byte("1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return &options{root: root, year: testYear, input: input}
+}
+
+// funcSolver solves with the functions it is given, calling partOne for both
+// parts
+type funcSolver struct {
+	parse   func() error
+	partOne func(ctx context.Context) (int, error)
+}
+
+func (s funcSolver) Parse(io.Reader) error {
+	if s.parse == nil {
+		return nil
+	}
+	return s.parse()
+}
+
+func (s funcSolver) PartOne(ctx context.Context) (int, error) {
+	return s.partOne(ctx)
+}
+
+func (s funcSolver) PartTwo(ctx context.Context) (int, error) {
+	return s.partOne(ctx)
+}
+
+func TestSolveTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	tests := []struct {
+		name    string
+		partOne func(ctx context.Context) (int, error)
+		status  string
+	}{
+		{
+			name: "watches its context",
+			partOne: func(ctx context.Context) (int, error) {
+				<-ctx.Done()
+				return 0, ctx.Err()
+			},
+			status: "timeout",
+		},
+		{
+			name: "ignores its context",
+			partOne: func(context.Context) (int, error) {
+				<-release
+				return 1, nil
+			},
+			status: "timeout",
+		},
+		{
+			name:    "fails in time",
+			partOne: func(context.Context) (int, error) { return 0, errors.New("no answer") },
+			status:  "error",
+		},
+		{
+			name:    "answers in time",
+			partOne: func(context.Context) (int, error) { return 1, nil },
+			status:  "ok",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := testOptions(t)
+			opts.timeout = 10 * time.Millisecond
+			day := testDay(3, func() aoc.Solver { return funcSolver{partOne: test.partOne} })
+
+			run, err := solve(context.Background(), opts, day, []int{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res := run.results[0]
+			if got := status(res.err); got != test.status {
+				t.Errorf("status = %s (%v), want %s", got, res.err, test.status)
+			}
+			if test.status == "timeout" && res.err.Error() != "timed out after 10ms" {
+				t.Errorf("error = %q, want timed out after 10ms", res.err)
+			}
+		})
+	}
+}
+
+func TestSolveAllKeepsDayOrder(t *testing.T) {
+	// the later days finish first
+	var days []aoc.Day
+	for day := 1; day <= 4; day++ {
+		days = append(days, testDay(day, func() aoc.Solver {
+			return funcSolver{
+				parse: func() error {
+					time.Sleep(time.Duration(5-day) * 10 * time.Millisecond)
+					return nil
+				},
+				partOne: func(context.Context) (int, error) { return day, nil },
+			}
+		}))
+	}
+
+	var got []int
+	for run, err := range solveAll(context.Background(), days, testOptions(t), []int{1}, len(days)) {
+		if err != nil {
+			t.Fatalf("%s: %v", run.day, err)
+		}
+		if answer := run.results[0].answer; answer != run.day.Day {
+			t.Errorf("%s answered %d, want %d", run.day, answer, run.day.Day)
+		}
+		got = append(got, run.day.Day)
+	}
+
+	if want := []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("days yielded in order %v, want %v", got, want)
+	}
+}
+
+func TestSolveAllCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the first day holds the only worker until the run is cancelled
+	started := make(chan struct{})
+	blocking := testDay(1, func() aoc.Solver {
+		return funcSolver{partOne: func(ctx context.Context) (int, error) {
+			close(started)
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}}
+	})
+
+	var parsed atomic.Int32
+	pending := func(day int) aoc.Day {
+		return testDay(day, func() aoc.Solver {
+			return funcSolver{
+				parse: func() error {
+					parsed.Add(1)
+					return nil
+				},
+				partOne: func(context.Context) (int, error) { return day, nil },
+			}
+		})
+	}
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	days := []aoc.Day{blocking, pending(2), pending(3)}
+	var got []int
+	for run, err := range solveAll(ctx, days, testOptions(t), []int{1}, 1) {
+		got = append(got, run.day.Day)
+
+		// the blocking day may be reported by its cancelled part
+		if err == nil {
+			err = run.results[0].err
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s: error = %v, want context.Canceled", run.day, err)
+		}
+	}
+
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("days yielded %v, want %v", got, want)
+	}
+	if n := parsed.Load(); n != 0 {
+		t.Errorf("%d pending days were started after cancelling", n)
+	}
+}
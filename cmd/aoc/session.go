@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/client"
+)
+
+const (
+	sessionEnv   = "AOC_SESSION"
+	userAgentEnv = "AOC_USER_AGENT"
+)
+
+// clientOptions are the flags shared by every command that talks to the site
+type clientOptions struct {
+	baseURL string
+}
+
+func (o *clientOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.baseURL, "base-url", client.DefaultBaseURL, "base URL of the Advent of Code website")
+}
+
+// newClient returns a client authenticated with the session cookie from the
+// environment or the .env file at root
+func (o *clientOptions) newClient(root string) (*client.Client, error) {
+	env, err := loadEnv(filepath.Join(root, ".env"))
+	if err != nil {
+		return nil, err
+	}
+
+	session := env[sessionEnv]
+	if session == "" {
+		return nil, errors.New("$" + sessionEnv + " isn't set, export it or add it to .env")
+	}
+
+	c := client.New(session)
+	c.BaseURL = o.baseURL
+	if ua := env[userAgentEnv]; ua != "" {
+		c.UserAgent = ua
+	}
+
+	return c, nil
+}
+
+// loadEnv returns the variables set in the .env file at path, if it exists,
+// overridden by the process environment
+func loadEnv(path string) (map[string]string, error) {
+	env := make(map[string]string)
+
+	file, err := os.Open(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if err == nil {
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+			if !ok {
+				continue
+			}
+
+			env[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range []string{sessionEnv, userAgentEnv, vaultKeyFileEnv, vaultPassphraseEnv} {
+		if value, ok := os.LookupEnv(key); ok {
+			env[key] = value
+		}
+	}
+
+	return env, nil
+}
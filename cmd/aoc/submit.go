@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/client"
+)
+
+// submissionsFile holds every submitted answer, relative to the root
+const submissionsFile = "submissions.json"
+
+type submission struct {
+	// Account identifies the session the answer was submitted with without
+	// revealing it, see accountID
+	Account string         `json:"account"`
+	Year    int            `json:"year"`
+	Day     int            `json:"day"`
+	Part    int            `json:"part"`
+	Answer  string         `json:"answer"`
+	Outcome client.Outcome `json:"outcome"`
+	At      time.Time      `json:"at"`
+	// Wait is how long the site asked to wait before the next submission
+	Wait time.Duration `json:"wait,omitempty"`
+}
+
+type submissionHistory struct {
+	Submissions []submission `json:"submissions"`
+}
+
+func submitCommand(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc submit [flags] <day> <part>")
+		fs.PrintDefaults()
+	}
+
+	// only the answer to the real input is worth submitting, so there is no
+	// -input to solve an example or someone else's input with
+	var opts options
+	var copts clientOptions
+	opts.registerRealInput(fs)
+	copts.register(fs)
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errors.New("expected a day and a part")
+	}
+
+	days, err := selectDays(opts.year, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	day := days[0]
+
+	part, err := parsePart(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	run, err := solve(context.Background(), &opts, day, []int{part})
+	if err != nil {
+		return err
+	}
+
+	res := run.results[0]
+	if res.err != nil {
+		return fmt.Errorf("%s part %d: %w", day, part, res.err)
+	}
+
+	historyPath := filepath.Join(opts.root, submissionsFile)
+	history, err := loadSubmissionHistory(historyPath)
+	if err != nil {
+		return err
+	}
+
+	c, err := copts.newClient(opts.root)
+	if err != nil {
+		return err
+	}
+	account := accountID(c.Session)
+
+	if err := history.check(account, day.Year, day.Day, part, res.answer, time.Now()); err != nil {
+		return fmt.Errorf("not submitting %d: %w", res.answer, err)
+	}
+
+	answer := aoc.FormatAnswer(res.answer)
+	fmt.Printf("submitting %s for %s part %d\n", answer, day, part)
+
+	verdict, err := c.Submit(context.Background(), day.Year, day.Day, part, answer)
+	if err != nil {
+		return err
+	}
+
+	history.Submissions = append(history.Submissions, submission{
+		Account: account,
+		Year:    day.Year,
+		Day:     day.Day,
+		Part:    part,
+		Answer:  answer,
+		Outcome: verdict.Outcome,
+		At:      time.Now().UTC(),
+		Wait:    verdict.Wait,
+	})
+
+	if err := history.save(historyPath); err != nil {
+		return err
+	}
+
+	fmt.Println(verdict.Outcome)
+	if verdict.Wait > 0 {
+		fmt.Printf("wait %s before submitting again\n", verdict.Wait)
+	}
+
+	if verdict.Outcome == client.Unknown {
+		fmt.Println(verdict.Message)
+	}
+
+	if verdict.Outcome != client.Correct {
+		return nil
+	}
+
+	// solving part one unlocks part two, refresh the description to include it
+	if part == 1 {
+		if err := savePuzzle(c, opts.root, day.Year, day.Day); err != nil {
+			log.Printf("failed to refresh the puzzle description: %v", err)
+		}
+	}
+
+	// record the accepted answer so verify can catch regressions
+	answers, err := aoc.LoadAnswers(opts.answersPath())
+	if err != nil {
+		return err
+	}
+
+	answers.Set(day.Year, day.Day, run.hash, part, answer)
+	return answers.Save(opts.answersPath())
+}
+
+// accountID returns a short identifier of the account a session cookie logs
+// in as, which unlike the cookie is safe to write down
+func accountID(session string) string {
+	sum := sha256.Sum256([]byte(session))
+	return hex.EncodeToString(sum[:6])
+}
+
+// check returns an error explaining why submitting answer would be pointless
+// given the account's earlier submissions: while the site still has the
+// account waiting after a wrong answer to any puzzle of the year, or when an
+// earlier submission for the same part already rules answer out
+func (h *submissionHistory) check(account string, year, day, part, answer int, now time.Time) error {
+	for _, prev := range h.Submissions {
+		if prev.Account != account || prev.Year != year {
+			continue
+		}
+
+		if prev.Wait > 0 && now.Before(prev.At.Add(prev.Wait)) {
+			return fmt.Errorf("rate limited, wait %s", prev.At.Add(prev.Wait).Sub(now).Round(time.Second))
+		}
+
+		if prev.Day != day || prev.Part != part {
+			continue
+		}
+
+		if prev.Outcome == client.Correct || prev.Outcome == client.AlreadySolved {
+			return fmt.Errorf("part already solved with %s", prev.Answer)
+		}
+
+		// rate limited submissions were never judged
+		if prev.Outcome == client.RateLimited {
+			continue
+		}
+
+		if prev.Answer == aoc.FormatAnswer(answer) {
+			return fmt.Errorf("%s was already submitted and is %s", prev.Answer, prev.Outcome)
+		}
+
+		bound, err := strconv.Atoi(prev.Answer)
+		if err != nil {
+			continue
+		}
+
+		if prev.Outcome == client.TooHigh && answer >= bound {
+			return fmt.Errorf("%d was too high", bound)
+		}
+
+		if prev.Outcome == client.TooLow && answer <= bound {
+			return fmt.Errorf("%d was too low", bound)
+		}
+	}
+
+	return nil
+}
+
+func loadSubmissionHistory(path string) (*submissionHistory, error) {
+	history := &submissionHistory{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return history, nil
+}
+
+func (h *submissionHistory) save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
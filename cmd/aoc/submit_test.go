@@ -0,0 +1,168 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/client"
+)
+
+func TestSubmissionHistoryCheck(t *testing.T) {
+	now := time.Date(2025, 12, 5, 6, 0, 0, 0, time.UTC)
+	me, other := accountID("mine"), accountID("theirs")
+
+	// an answer to day 5 part 1 from an hour ago
+	wrong := func(answer string, outcome client.Outcome) submission {
+		return submission{Account: me, Year: 2025, Day: 5, Part: 1, Answer: answer, Outcome: outcome, At: now.Add(-time.Hour)}
+	}
+
+	tests := []struct {
+		name    string
+		history []submission
+		account string
+		part    int
+		answer  int
+		wantErr string // empty if the answer may be submitted
+	}{
+		{name: "no history", answer: 10},
+		{
+			name:    "duplicate",
+			history: []submission{wrong("10", client.Incorrect)},
+			answer:  10,
+			wantErr: "10 was already submitted and is incorrect",
+		},
+		{
+			name:    "different answer",
+			history: []submission{wrong("10", client.Incorrect)},
+			answer:  11,
+		},
+		{
+			name:    "above too high",
+			history: []submission{wrong("100", client.TooHigh)},
+			answer:  150,
+			wantErr: "100 was too high",
+		},
+		{
+			name:    "duplicate too high",
+			history: []submission{wrong("100", client.TooHigh)},
+			answer:  100,
+			wantErr: "100 was already submitted and is too high",
+		},
+		{
+			name:    "below too high",
+			history: []submission{wrong("100", client.TooHigh)},
+			answer:  99,
+		},
+		{
+			name:    "below too low",
+			history: []submission{wrong("100", client.TooLow)},
+			answer:  50,
+			wantErr: "100 was too low",
+		},
+		{
+			name:    "above too low",
+			history: []submission{wrong("100", client.TooLow)},
+			answer:  101,
+		},
+		{
+			name:    "between bounds",
+			history: []submission{wrong("100", client.TooLow), wrong("200", client.TooHigh)},
+			answer:  150,
+		},
+		{
+			name:    "already solved",
+			history: []submission{wrong("42", client.Correct)},
+			answer:  43,
+			wantErr: "part already solved with 42",
+		},
+		{
+			name:    "rate limited submissions weren't judged",
+			history: []submission{wrong("10", client.RateLimited)},
+			answer:  10,
+		},
+		{
+			name:    "other part",
+			history: []submission{wrong("10", client.Incorrect), wrong("100", client.TooHigh)},
+			part:    2,
+			answer:  150,
+		},
+		{
+			name:    "other account",
+			history: []submission{wrong("10", client.Incorrect)},
+			account: other,
+			answer:  10,
+		},
+		{
+			name: "cooling down",
+			history: []submission{
+				{Account: me, Year: 2025, Day: 5, Part: 1, Answer: "10", Outcome: client.Incorrect, At: now.Add(-30 * time.Second), Wait: time.Minute},
+			},
+			answer:  11,
+			wantErr: "rate limited, wait 30s",
+		},
+		{
+			name: "cooldown over",
+			history: []submission{
+				{Account: me, Year: 2025, Day: 5, Part: 1, Answer: "10", Outcome: client.Incorrect, At: now.Add(-2 * time.Minute), Wait: time.Minute},
+			},
+			answer: 11,
+		},
+		{
+			name: "cooling down on another day",
+			history: []submission{
+				{Account: me, Year: 2025, Day: 3, Part: 2, Answer: "7", Outcome: client.Incorrect, At: now.Add(-30 * time.Second), Wait: 5 * time.Minute},
+			},
+			answer:  11,
+			wantErr: "rate limited, wait 4m30s",
+		},
+		{
+			name: "another account cooling down",
+			history: []submission{
+				{Account: other, Year: 2025, Day: 5, Part: 1, Answer: "10", Outcome: client.Incorrect, At: now.Add(-30 * time.Second), Wait: time.Minute},
+			},
+			answer: 11,
+		},
+		{
+			name: "cooling down in another year",
+			history: []submission{
+				{Account: me, Year: 2024, Day: 5, Part: 1, Answer: "10", Outcome: client.Incorrect, At: now.Add(-30 * time.Second), Wait: time.Minute},
+			},
+			answer: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, part := tt.account, tt.part
+			if account == "" {
+				account = me
+			}
+			if part == 0 {
+				part = 1
+			}
+
+			h := &submissionHistory{Submissions: tt.history}
+			err := h.check(account, 2025, 5, part, tt.answer, now)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("check() = %v, want no error", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("check() = nil, want %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("check() = %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccountID(t *testing.T) {
+	id := accountID("53616c7465645f5f")
+	if strings.Contains(id, "53616c74") {
+		t.Errorf("accountID() = %q reveals the session", id)
+	}
+	if id != accountID("53616c7465645f5f") || id == accountID("53616c7465645f5e") {
+		t.Errorf("accountID() = %q isn't stable and distinct per session", id)
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/vault"
+)
+
+const (
+	vaultKeyFileEnv    = "AOC_VAULT_KEY_FILE"
+	vaultPassphraseEnv = "AOC_VAULT_PASSPHRASE"
+
+	// defaultVaultKeyFile is where keygen writes a key, relative to the root
+	defaultVaultKeyFile = ".vault-key"
+)
+
+func vaultCommand(args []string) error {
+	fs := flag.NewFlagSet("vault", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc vault encrypt [flags] [day|all]")
+		fmt.Fprintln(fs.Output(), "       aoc vault decrypt [flags] [day|all]")
+		fmt.Fprintln(fs.Output(), "       aoc vault keygen [flags]")
+		fmt.Fprintln(fs.Output(), "\nThe key is read from the file named by -key-file or $"+vaultKeyFileEnv+",")
+		fmt.Fprintln(fs.Output(), "or derived from $"+vaultPassphraseEnv+". Both may also be set in .env.")
+		fmt.Fprintln(fs.Output(), "Without either the key written by keygen to "+defaultVaultKeyFile+" is used.")
+		fmt.Fprintln(fs.Output(), "\nflags:")
+		fs.PrintDefaults()
+	}
+
+	root := fs.String("root", ".", "repository root holding the day_XX directories")
+	year := fs.Int("year", aoc.DefaultYear, "event the days belong to")
+	keyFile := fs.String("key-file", "", "file holding the vault key, for keygen the file to create (default "+defaultVaultKeyFile+")")
+	force := fs.Bool("force", false, "decrypt over existing inputs")
+
+	if len(args) == 0 {
+		fs.Usage()
+		return errors.New("expected encrypt, decrypt or keygen")
+	}
+	action := args[0]
+	fs.Parse(args[1:])
+
+	if action == "keygen" {
+		if fs.NArg() != 0 {
+			fs.Usage()
+			return errors.New("keygen takes no arguments")
+		}
+		return vaultKeygen(*root, *keyFile)
+	}
+
+	if action != "encrypt" && action != "decrypt" {
+		fs.Usage()
+		return fmt.Errorf("unknown vault action %q", action)
+	}
+
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return errors.New("expected at most one day")
+	}
+
+	arg := "all"
+	if fs.NArg() == 1 {
+		arg = fs.Arg(0)
+	}
+
+	days, err := selectDays(*year, arg)
+	if err != nil {
+		return err
+	}
+
+	key, err := loadVaultKey(*root, *keyFile)
+	if err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		input := filepath.Join(*root, day.Dir(), "input.txt")
+
+		var status string
+		if action == "encrypt" {
+			status, err = encryptInput(key, day, input)
+		} else {
+			status, err = decryptInput(key, day, input, *force)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", day, err)
+		}
+
+		if status != "" {
+			fmt.Printf("%s: %s\n", day, status)
+		}
+	}
+
+	return nil
+}
+
+// encryptInput seals input into its vault blob and describes what was done.
+// A blob that already holds the same input is left alone so that re-running
+// encrypt doesn't churn every blob in git.
+func encryptInput(key vault.Key, day aoc.Day, input string) (string, error) {
+	plaintext, err := os.ReadFile(input)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	blobPath := input + vault.Ext
+	if blob, err := os.ReadFile(blobPath); err == nil {
+		if existing, err := vault.Decrypt(key, blob, vaultLabel(day)); err == nil && bytes.Equal(existing, plaintext) {
+			return "unchanged", nil
+		}
+	}
+
+	blob, err := vault.Encrypt(key, plaintext, vaultLabel(day))
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeFileAtomic(blobPath, blob); err != nil {
+		return "", err
+	}
+
+	return "encrypted to " + blobPath, nil
+}
+
+// decryptInput restores input from its vault blob and describes what was done
+func decryptInput(key vault.Key, day aoc.Day, input string, force bool) (string, error) {
+	blob, err := os.ReadFile(input + vault.Ext)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := vault.Decrypt(key, blob, vaultLabel(day))
+	if err != nil {
+		return "", err
+	}
+
+	if existing, err := os.ReadFile(input); err == nil {
+		if bytes.Equal(existing, plaintext) {
+			return "unchanged", nil
+		}
+		if !force {
+			return "", fmt.Errorf("%s differs from its vault blob, use -force to overwrite it", input)
+		}
+	}
+
+	if err := writeFileAtomic(input, plaintext); err != nil {
+		return "", err
+	}
+
+	return "decrypted to " + input, nil
+}
+
+func vaultKeygen(root, keyFile string) error {
+	if keyFile == "" {
+		keyFile = filepath.Join(root, defaultVaultKeyFile)
+	}
+
+	// never replace a key, the blobs sealed with it would be lost
+	f, err := os.OpenFile(keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(vault.GenerateKeyFile()); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("wrote a new vault key to %s, keep it out of git and share it with your team\n", keyFile)
+	if keyFile != filepath.Join(root, defaultVaultKeyFile) {
+		fmt.Printf("set %s=%s in .env to use it\n", vaultKeyFileEnv, keyFile)
+	}
+	return nil
+}
+
+// loadVaultKey returns the vault key from keyFile, if set, or else from the
+// key file or passphrase named by the environment or the .env file at root,
+// or else from the key keygen writes to <root>/.vault-key. Relative key file
+// paths in .env are relative to root.
+func loadVaultKey(root, keyFile string) (vault.Key, error) {
+	if keyFile != "" {
+		return readVaultKeyFile(keyFile)
+	}
+
+	env, err := loadEnv(filepath.Join(root, ".env"))
+	if err != nil {
+		return vault.Key{}, err
+	}
+
+	if keyFile := env[vaultKeyFileEnv]; keyFile != "" {
+		if !filepath.IsAbs(keyFile) {
+			keyFile = filepath.Join(root, keyFile)
+		}
+		return readVaultKeyFile(keyFile)
+	}
+
+	if passphrase := env[vaultPassphraseEnv]; passphrase != "" {
+		return vault.Passphrase(passphrase)
+	}
+
+	key, err := readVaultKeyFile(filepath.Join(root, defaultVaultKeyFile))
+	if !errors.Is(err, fs.ErrNotExist) {
+		return key, err
+	}
+
+	return vault.Key{}, errors.New("no vault key, set $" + vaultKeyFileEnv + " or $" + vaultPassphraseEnv + " or run aoc vault keygen")
+}
+
+func readVaultKeyFile(path string) (vault.Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return vault.Key{}, err
+	}
+	return vault.KeyFile(data)
+}
+
+// vaultLabel binds a blob to the day it belongs to, independently of where
+// the repository is checked out
+func vaultLabel(day aoc.Day) string {
+	return path.Join(filepath.ToSlash(day.Dir()), "input.txt")
+}
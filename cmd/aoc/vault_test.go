@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/vault"
+)
+
+func TestLoadVaultKeyDefaultFile(t *testing.T) {
+	t.Setenv(vaultKeyFileEnv, "")
+	t.Setenv(vaultPassphraseEnv, "")
+	root := t.TempDir()
+
+	if _, err := loadVaultKey(root, ""); err == nil {
+		t.Fatal("loadVaultKey() found a key before keygen")
+	}
+
+	if err := vaultKeygen(root, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := loadVaultKey(root, "")
+	if err != nil {
+		t.Fatalf("loadVaultKey() after keygen = %v", err)
+	}
+
+	// the key must be the one keygen wrote for blobs to open
+	data, err := os.ReadFile(filepath.Join(root, defaultVaultKeyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	written, err := vault.KeyFile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blob, err := vault.Encrypt(written, []byte("1\n"), "day_01/input.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vault.Decrypt(key, blob, "day_01/input.txt"); err != nil {
+		t.Errorf("loaded key can't open a blob sealed with the key keygen wrote: %v", err)
+	}
+}
+
+func TestLoadVaultKeyPrefersEnv(t *testing.T) {
+	root := t.TempDir()
+	if err := vaultKeygen(root, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(vaultKeyFileEnv, "")
+	t.Setenv(vaultPassphraseEnv, "correct horse battery staple")
+
+	key, err := loadVaultKey(root, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	passphrase, err := vault.Passphrase("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blob, err := vault.Encrypt(passphrase, []byte("1\n"), "day_01/input.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vault.Decrypt(key, blob, "day_01/input.txt"); err != nil {
+		t.Errorf("loadVaultKey() used %s over $%s: %v", defaultVaultKeyFile, vaultPassphraseEnv, err)
+	}
+}
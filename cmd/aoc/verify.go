@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func verifyCommand(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc verify [flags] [day|all]")
+		fs.PrintDefaults()
+	}
+
+	var opts options
+	opts.register(fs)
+	record := fs.Bool("record", false, "record the computed answer for parts with no recorded answer")
+	fs.Parse(args)
+
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return errors.New("expected at most one day")
+	}
+
+	arg := "all"
+	if fs.NArg() == 1 {
+		arg = fs.Arg(0)
+	}
+
+	days, err := selectDays(opts.year, arg)
+	if err != nil {
+		return err
+	}
+
+	answers, err := aoc.LoadAnswers(opts.answersPath())
+	if err != nil {
+		return err
+	}
+
+	var passed, failed, missing, recorded int
+	for _, day := range days {
+		run, err := solve(context.Background(), &opts, day, []int{1, 2})
+		if errors.Is(err, os.ErrNotExist) {
+			// nothing to check rather than a wrong answer, such as a day
+			// whose input hasn't been fetched
+			fmt.Printf("%s: missing input\n    %v\n", day, err)
+			missing++
+			continue
+		}
+		if err != nil {
+			fmt.Printf("%s: FAIL\n    %v\n", day, err)
+			failed++
+			continue
+		}
+
+		// expectations are keyed by input hash so a different input shows up
+		// as missing rather than failing, point out why
+		if warning := checkInputHash(answers, run); warning != "" {
+			fmt.Printf("warning: %s\n", warning)
+		}
+
+		for _, res := range run.results {
+			if errors.Is(res.err, aoc.ErrNoPart) {
+				continue
+			}
+
+			label := fmt.Sprintf("%s part %d", day, res.part)
+			expected, ok := answers.Get(day.Year, day.Day, run.hash, res.part)
+
+			switch {
+			case res.err != nil:
+				fmt.Printf("%s: FAIL\n    error:    %v\n", label, res.err)
+				failed++
+			case !ok && *record:
+				answers.Set(day.Year, day.Day, run.hash, res.part, aoc.FormatAnswer(res.answer))
+				fmt.Printf("%s: recorded %d for input %s\n", label, res.answer, shortHash(run.hash))
+				recorded++
+			case !ok:
+				fmt.Printf("%s: missing (got %d)\n", label, res.answer)
+				missing++
+			case expected != aoc.FormatAnswer(res.answer):
+				fmt.Printf("%s: FAIL\n    expected: %s\n    got:      %d\n", label, expected, res.answer)
+				failed++
+			default:
+				fmt.Printf("%s: pass\n", label)
+				passed++
+			}
+		}
+	}
+
+	if recorded > 0 {
+		if err := answers.Save(opts.answersPath()); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d missing", passed, failed, missing)
+	if recorded > 0 {
+		fmt.Printf(", %d recorded", recorded)
+	}
+	fmt.Println()
+
+	if failed > 0 {
+		return errors.New(plural(failed, "regression"))
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func TestVerify(t *testing.T) {
+	// testYear's day 1 answers 42 to part one and has no part two
+	hash := aoc.HashInput([]byte("1\n"))
+
+	tests := []struct {
+		name     string
+		recorded string // part one's recorded answer, none if empty
+		noInput  bool
+		record   bool
+		output   string
+		wantErr  string
+		// what answers.json holds afterwards, the same as before if empty
+		saved string
+	}{
+		{name: "pass", recorded: "42", output: "1 passed, 0 failed, 0 missing"},
+		{name: "fail", recorded: "41", output: "expected: 41", wantErr: "1 regression"},
+		{name: "missing answer", output: "missing (got 42)"},
+		{name: "record", record: true, output: "0 passed, 0 failed, 0 missing, 1 recorded", saved: "42"},
+		{name: "record keeps answers", recorded: "41", record: true, output: "expected: 41", wantErr: "1 regression"},
+		{name: "missing input", recorded: "42", noInput: true, output: "missing input"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			day := aoc.Day{Year: testYear, Day: 1}
+
+			if !test.noInput {
+				dir := filepath.Join(root, day.Dir())
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, "input.txt"), []byte("1\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			answersPath := filepath.Join(root, aoc.AnswersFile)
+			answers := make(aoc.Answers)
+			if test.recorded != "" {
+				answers.Set(testYear, 1, hash, 1, test.recorded)
+			}
+			if err := answers.Save(answersPath); err != nil {
+				t.Fatal(err)
+			}
+
+			args := []string{"-root", root, "-year", fmt.Sprint(testYear)}
+			if test.record {
+				args = append(args, "-record")
+			}
+
+			var err error
+			stdout, _ := capture(t, func() {
+				err = verifyCommand(append(args, "1"))
+			})
+
+			switch {
+			case test.wantErr == "" && err != nil:
+				t.Errorf("verifyCommand() = %v, want no error", err)
+			case test.wantErr != "" && (err == nil || err.Error() != test.wantErr):
+				t.Errorf("verifyCommand() = %v, want %s", err, test.wantErr)
+			}
+
+			if !strings.Contains(stdout, test.output) {
+				t.Errorf("output = %q, want it to contain %q", stdout, test.output)
+			}
+
+			saved, err := aoc.LoadAnswers(answersPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := test.recorded
+			if test.saved != "" {
+				want = test.saved
+			}
+			if got, _ := saved.Get(testYear, 1, hash, 1); got != want {
+				t.Errorf("recorded answer = %q, want %q", got, want)
+			}
+		})
+	}
+}
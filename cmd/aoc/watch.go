@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func watchCommand(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc watch [flags] <day>")
+		fs.PrintDefaults()
+	}
+
+	var opts options
+	opts.register(fs)
+	interval := fs.Duration("interval", 500*time.Millisecond, "how often the day's files are checked for changes")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("expected a day")
+	}
+
+	day, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("invalid day %q", fs.Arg(0))
+	}
+
+	root, err := filepath.Abs(opts.root)
+	if err != nil {
+		return err
+	}
+
+	// the day may not be registered yet, or may not compile, so watch its
+	// directory rather than looking it up
+	dir := filepath.Join(root, aoc.DayDir(opts.year, day))
+	if _, err := os.Stat(dir); err != nil {
+		return err
+	}
+
+	// the shared packages and the command itself are rebuilt along with the day
+	watched := []string{dir, filepath.Join(root, "aoc"), filepath.Join(root, "cmd", "aoc")}
+	if opts.input != "" && opts.input != aoc.StdinName && !strings.HasPrefix(opts.input, aoc.ExamplePrefix) {
+		watched = append(watched, opts.input)
+	}
+
+	tmp, err := os.MkdirTemp("", "aoc-watch-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	w := &watcher{
+		root:   root,
+		binary: filepath.Join(tmp, "aoc"),
+		args:   watchRunArgs(&opts, root, day),
+		label:  aoc.Day{Year: opts.year, Day: day}.String(),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("watching %s along with aoc and cmd/aoc, press Ctrl+C to stop\n", dir)
+
+	var last string
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		snapshot, err := snapshotFiles(watched)
+		if err != nil {
+			return err
+		}
+
+		if snapshot != last {
+			last = snapshot
+			w.rerun(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchRunArgs are the arguments the rebuilt binary is run with
+func watchRunArgs(opts *options, root string, day int) []string {
+	args := []string{"run", "-format", "json", "-root", root, "-year", strconv.Itoa(opts.year)}
+	if opts.input != "" {
+		args = append(args, "-input", opts.input)
+	}
+	if opts.timeout > 0 {
+		args = append(args, "-timeout", opts.timeout.String())
+	}
+	return append(args, strconv.Itoa(day))
+}
+
+// watcher rebuilds the aoc command and runs a day with it
+type watcher struct {
+	root   string
+	binary string
+	args   []string
+	label  string
+
+	prev    []row // rows of the last successful run
+	started bool
+}
+
+func (w *watcher) rerun(ctx context.Context) {
+	event := "changed, rebuilding"
+	if !w.started {
+		event, w.started = "building", true
+	}
+	fmt.Printf("\n[%s] %s %s\n", time.Now().Format(time.TimeOnly), w.label, event)
+
+	build := exec.CommandContext(ctx, "go", "build", "-o", w.binary, "./cmd/aoc")
+	build.Dir = w.root
+	if out, err := build.CombinedOutput(); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		fmt.Printf("build failed:\n%s", indent(string(out)))
+		return
+	}
+
+	var stdout, stderr bytes.Buffer
+	run := exec.CommandContext(ctx, w.binary, w.args...)
+	run.Dir = w.root
+	run.Stdout = &stdout
+	run.Stderr = &stderr
+
+	// failing parts make the run exit non-zero, they are reported in the rows
+	runErr := run.Run()
+	if ctx.Err() != nil {
+		return
+	}
+
+	var rows []row
+	if err := json.Unmarshal(stdout.Bytes(), &rows); err != nil || len(rows) == 0 {
+		fmt.Printf("run failed: %v\n%s", runErr, indent(stderr.String()))
+		return
+	}
+
+	// warnings, such as a mismatched input hash
+	if stderr.Len() > 0 {
+		for line := range strings.Lines(stderr.String()) {
+			if !strings.Contains(line, "warning:") {
+				continue
+			}
+			fmt.Print(line)
+		}
+	}
+
+	w.show(rows)
+	w.prev = rows
+}
+
+// show prints the new rows next to the previous ones
+func (w *watcher) show(rows []row) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "part\tprevious\tnew\tprevious time\tnew time")
+
+	for _, r := range rows {
+		prev, hasPrev := w.previous(r.Part)
+
+		var prevAnswer, prevTime string
+		if hasPrev {
+			prevAnswer, prevTime = answerCell(prev), timeCell(prev)
+		} else {
+			prevAnswer, prevTime = "-", "-"
+		}
+
+		part := "-"
+		if r.Part > 0 {
+			part = strconv.Itoa(r.Part)
+		}
+
+		newAnswer := answerCell(r)
+		if hasPrev && prevAnswer != newAnswer {
+			newAnswer += " (changed)"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", part, prevAnswer, newAnswer, prevTime, timeCell(r))
+	}
+	tw.Flush()
+
+	for _, r := range rows {
+		if r.Error == "" {
+			continue
+		}
+
+		label := "input"
+		if r.Part > 0 {
+			label = fmt.Sprintf("part %d", r.Part)
+		}
+		fmt.Printf("%s: %s", label, strings.TrimPrefix(indent(r.Error), "\t"))
+	}
+}
+
+func (w *watcher) previous(part int) (row, bool) {
+	i := slices.IndexFunc(w.prev, func(r row) bool { return r.Part == part })
+	if i < 0 {
+		return row{}, false
+	}
+	return w.prev[i], true
+}
+
+func answerCell(r row) string {
+	if r.Answer == nil {
+		return r.Status
+	}
+	return aoc.FormatAnswer(*r.Answer)
+}
+
+// timeCell renders the time taken to parse and solve a part
+func timeCell(r row) string {
+	return fmt.Sprintf("%s + %s", roundDuration(r.ParseTime), roundDuration(r.SolveTime))
+}
+
+// indent prefixes every line of s with a tab
+func indent(s string) string {
+	var sb strings.Builder
+	for line := range strings.Lines(s) {
+		sb.WriteString("\t" + line)
+	}
+	if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// snapshotFiles summarises the size and modification time of every file
+// under paths so that any change to them changes the summary. Files under
+// testdata directories, such as the fuzz corpus, are left out as they don't
+// change what a run prints.
+func snapshotFiles(paths []string) (string, error) {
+	var sb strings.Builder
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// files can disappear between listing and reading them
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+
+			if d.IsDir() && d.Name() == "testdata" {
+				return fs.SkipDir
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(&sb, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSnapshotFiles(t *testing.T) {
+	root := t.TempDir()
+	write := func(name, content string, mtime time.Time) {
+		t.Helper()
+
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	write("day_01/main.go", "package day01", start)
+	write("aoc/grid.go", "package aoc", start)
+
+	paths := []string{filepath.Join(root, "day_01"), filepath.Join(root, "aoc"), filepath.Join(root, "missing")}
+	snapshot := func() string {
+		t.Helper()
+
+		s, err := snapshotFiles(paths)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	before := snapshot()
+
+	write("day_01/testdata/fuzz/FuzzParseInput/0123", "go test fuzz v1", start.Add(time.Minute))
+	write("aoc/leaderboard/testdata/board.json", "{}", start.Add(time.Minute))
+	if after := snapshot(); after != before {
+		t.Errorf("snapshot changed with testdata:\n%s\nwant\n%s", after, before)
+	}
+
+	for _, name := range []string{"day_01/main.go", "aoc/grid.go", "aoc/parse/parse.go"} {
+		write(name, "package changed", start.Add(time.Hour))
+		if after := snapshot(); after == before {
+			t.Errorf("snapshot didn't change with %s", name)
+		}
+		before = snapshot()
+	}
+}
@@ -0,0 +1,27 @@
+package day01
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(1, generate)
+}
+
+// generate writes size rotations of up to 999 clicks in either direction
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+	for range size {
+		dir := 'L'
+		if rng.IntN(2) == 0 {
+			dir = 'R'
+		}
+		fmt.Fprintf(bw, "%c%d\n", dir, 1+rng.IntN(999))
+	}
+	return bw.Flush()
+}
@@ -1,14 +1,20 @@
-package main
+package day01
 
 import (
-	"flag"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"iter"
 	"strconv"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
 )
 
+//go:embed examples
+var examples embed.FS
+
 const (
 	Dir  = 0
 	Dist = 1
@@ -17,41 +23,60 @@ const (
 	R = 1
 )
 
-func ParseInput(lines []string) ([][2]int, error) {
-	rotations := make([][2]int, len(lines))
-	for i, line := range lines {
+func ParseInput(lines iter.Seq2[string, error]) ([][2]int, error) {
+	var rotations [][2]int
+
+	var lineNum int
+	for line, err := range lines {
+		if err != nil {
+			return nil, err
+		}
+		lineNum++
+
+		if line == "" {
+			return nil, aoc.NewParseError(lineNum, line, errors.New("empty line, expected a rotation"))
+		}
+
 		var dir int
-		if line[0] == 'L' {
+		switch line[0] {
+		case 'L':
 			dir = L
-		} else {
+		case 'R':
 			dir = R
+		default:
+			err := fmt.Errorf("invalid direction %q, expected L or R", line[0])
+			return nil, &aoc.ParseError{Line: lineNum, Column: 1, Text: line, Err: err}
 		}
 
 		dist, err := strconv.Atoi(line[1:])
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert %s to int at line %d: %w", line[1:], i+1, err)
+			return nil, &aoc.ParseError{Line: lineNum, Column: 2, Text: line, Err: err}
 		}
 
-		rotations[i] = [2]int{dir, dist}
+		rotations = append(rotations, [2]int{dir, dist})
 	}
 	return rotations, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
-	lines, err := aoc.ReadInputLineByLine(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+type solver struct {
+	rotations [][2]int
+}
 
-	rotations, err := ParseInput(lines)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(1, examples, func() aoc.Solver { return &solver{} })
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.rotations, err = ParseInput(aoc.Lines(r))
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.rotations), nil
+}
 
-	fmt.Println("solution to part one: ", PartOne(rotations))
-	fmt.Println("solution to part two: ", PartTwo(rotations))
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.rotations), nil
 }
 
 func PartOne(rotations [][2]int) int {
@@ -0,0 +1,47 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2, generate)
+}
+
+const (
+	// maxID keeps generated ids within the ten digits of real inputs
+	maxID = 9_999_999_999
+
+	// maxSpan bounds how many ids a range covers so the brute force parts
+	// stay quick
+	maxSpan = 100_000
+)
+
+// generate writes size disjoint ranges in random order. The ranges climb
+// through every magnitude of id up to maxID.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	ranges := make([]string, 0, size)
+
+	lower := 1 + rng.IntN(20)
+	for i := range size {
+		// share the ids left between the remaining ranges so they never run
+		// past maxID
+		budget := max((maxID-lower)/(size-i)/2, 1)
+
+		upper := lower + rng.IntN(min(maxSpan, budget))
+		ranges = append(ranges, fmt.Sprintf("%d-%d", lower, upper))
+
+		// grow the gap with the ids so large inputs reach long ids
+		lower = upper + 1 + rng.IntN(min(upper/4+10, budget))
+	}
+
+	rng.Shuffle(len(ranges), func(i, j int) { ranges[i], ranges[j] = ranges[j], ranges[i] })
+
+	_, err := io.WriteString(w, strings.Join(ranges, ",")+"\n")
+	return err
+}
@@ -1,62 +1,83 @@
-package main
+package day02
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
-	"strconv"
+	"io"
 	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
+//go:embed examples
+var examples embed.FS
+
+var rangePattern = parse.MustCompile("{int}-{int}", nil)
+
 func ParseInput(input string) ([][2]int, error) {
 	input = strings.TrimSpace(input)
 	rangesStr := strings.Split(input, ",")
 
+	// offset of the current range within the input
+	var offset int
+
 	ranges := make([][2]int, len(rangesStr))
 	for i, rangeStr := range rangesStr {
-		parts := strings.Split(rangeStr, "-")
-
-		lower, err := strconv.Atoi(parts[0])
-		if err != nil {
-			return nil, err
-		}
-
-		upper, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, err
+		var lower, upper int
+		if err := rangePattern.Scan(rangeStr, &lower, &upper); err != nil {
+			perr := aoc.NewParseError(1, input, err)
+			perr.Column += offset
+			return nil, perr
 		}
 
 		ranges[i] = [2]int{lower, upper}
+		offset += len(rangeStr) + 1
 	}
 	return ranges, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	ranges [][2]int
+}
 
-	input, err := aoc.ReadInput(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(2, examples, func() aoc.Solver { return &solver{} })
+}
 
-	ranges, err := ParseInput(input)
+func (s *solver) Parse(r io.Reader) error {
+	input, err := aoc.ReadString(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(ranges))
-	fmt.Println("solution to part two: ", PartTwo(ranges))
+	s.ranges, err = ParseInput(input)
+	return err
 }
 
-func PartOne(ranges [][2]int) int {
+func (s *solver) PartOne(ctx context.Context) (int, error) {
+	return PartOne(ctx, s.ranges)
+}
+
+func (s *solver) PartTwo(ctx context.Context) (int, error) {
+	return PartTwo(ctx, s.ranges)
+}
+
+// cancelCheckInterval is how many ids are checked between looking for
+// cancellation, ranges can span billions of ids
+const cancelCheckInterval = 1 << 16
+
+func PartOne(ctx context.Context, ranges [][2]int) (int, error) {
 	var total int
 
 	for _, idRange := range ranges {
 		lower, upper := idRange[0], idRange[1]
 		for i := lower; i <= upper; i++ {
+			if (i-lower)%cancelCheckInterval == 0 && ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+
 			iStr := fmt.Sprintf("%d", i)
 			numDigits := len(iStr)
 			if numDigits%2 == 1 {
@@ -69,15 +90,19 @@ func PartOne(ranges [][2]int) int {
 		}
 	}
 
-	return total
+	return total, nil
 }
 
-func PartTwo(ranges [][2]int) int {
+func PartTwo(ctx context.Context, ranges [][2]int) (int, error) {
 	var total int
 
 	for _, idRange := range ranges {
 		lower, upper := idRange[0], idRange[1]
 		for i := lower; i <= upper; i++ {
+			if (i-lower)%cancelCheckInterval == 0 && ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+
 			iStr := fmt.Sprintf("%d", i)
 			if isRepeating(iStr) {
 				total += i
@@ -85,7 +110,7 @@ func PartTwo(ranges [][2]int) int {
 		}
 	}
 
-	return total
+	return total, nil
 }
 
 func isRepeating(s string) bool {
@@ -0,0 +1,19 @@
+package day02
+
+import (
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 2)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 2)
+}
@@ -0,0 +1,28 @@
+package day03
+
+import (
+	"bufio"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(3, generate)
+}
+
+// bankSize is the number of batteries in a bank of the real inputs
+const bankSize = 100
+
+// generate writes size banks of batteries rated 1 to 9
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+	for range size {
+		for range bankSize {
+			bw.WriteByte(byte('1' + rng.IntN(9)))
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
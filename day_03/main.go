@@ -1,38 +1,67 @@
-package main
+package day03
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
+	"io"
 	"math"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
 )
 
-func ParseInput(lines []string) [][]int {
+//go:embed examples
+var examples embed.FS
+
+// maxBatteries is the most batteries turned on in a bank, by part two
+const maxBatteries = 12
+
+func ParseInput(lines []string) ([][]int, error) {
 	banks := make([][]int, len(lines))
 	for i := range lines {
+		if len(lines[i]) < maxBatteries {
+			err := fmt.Errorf("bank has %d batteries, expected at least %d", len(lines[i]), maxBatteries)
+			return nil, aoc.NewParseError(i+1, lines[i], err)
+		}
+
 		bank := make([]int, len(lines[i]))
 		for j := range lines[i] {
-			bank[j] = int(lines[i][j] - '0')
+			digit := lines[i][j]
+			if digit < '0' || digit > '9' {
+				err := fmt.Errorf("invalid joltage %q, expected a digit", digit)
+				return nil, &aoc.ParseError{Line: i + 1, Column: j + 1, Text: lines[i], Err: err}
+			}
+			bank[j] = int(digit - '0')
 		}
 		banks[i] = bank
 	}
-	return banks
+	return banks, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	banks [][]int
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
+func init() {
+	aoc.Register(3, examples, func() aoc.Solver { return &solver{} })
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	banks := ParseInput(lines)
-	fmt.Println("solution to part one: ", PartOne(banks))
-	fmt.Println("solution to part two: ", PartTwo(banks))
+	s.banks, err = ParseInput(lines)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.banks), nil
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.banks), nil
 }
 
 func PartOne(banks [][]int) int {
@@ -46,7 +75,7 @@ func PartOne(banks [][]int) int {
 func PartTwo(banks [][]int) int {
 	var total int
 	for _, bank := range banks {
-		total += joltage(bank, 12)
+		total += joltage(bank, maxBatteries)
 	}
 	return total
 }
@@ -73,4 +102,3 @@ func joltage(bank []int, batteries int) int {
 
 	return joltage
 }
-
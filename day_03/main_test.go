@@ -0,0 +1,19 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 3)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 3)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 3)
+}
@@ -0,0 +1,30 @@
+package day04
+
+import (
+	"bufio"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(4, generate)
+}
+
+// generate writes a size by size grid where roughly two in three tiles hold a
+// roll of paper
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+	for range size {
+		for range size {
+			tile := byte('.')
+			if rng.IntN(3) > 0 {
+				tile = '@'
+			}
+			bw.WriteByte(tile)
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
@@ -1,62 +1,70 @@
-package main
+package day04
 
 import (
-	"flag"
-	"fmt"
-	"log"
+	"context"
+	"embed"
+	"io"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
 )
 
-func ParseInput(lines []string) [][]rune {
-	grid := make([][]rune, len(lines))
-	for i, line := range lines {
-		grid[i] = []rune(line)
-	}
-	return grid
+//go:embed examples
+var examples embed.FS
+
+func ParseInput(lines []string) (*aoc.Grid[rune], error) {
+	return aoc.ParseRuneGrid(lines)
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	grid *aoc.Grid[rune]
+}
+
+func init() {
+	aoc.Register(4, examples, func() aoc.Solver { return &solver{} })
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	grid := ParseInput(lines)
+	s.grid, err = ParseInput(lines)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.grid), nil
+}
 
-	fmt.Println("solution to part one: ", PartOne(grid))
-	fmt.Println("solution to part two: ", PartTwo(grid))
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.grid), nil
 }
 
-func PartOne(grid [][]rune) int {
+func PartOne(grid *aoc.Grid[rune]) int {
 	var count int
 
-	for y := range grid {
-		for x := range grid[y] {
-			if grid[y][x] == '@' && neighRolls(x, y, grid) < 4 {
-				count++
-			}
+	for pos, tile := range grid.All() {
+		if tile == '@' && neighRolls(pos, grid) < 4 {
+			count++
 		}
 	}
 
 	return count
 }
 
-func PartTwo(grid [][]rune) int {
+func PartTwo(grid *aoc.Grid[rune]) int {
+	grid = grid.Clone()
+
 	var count int
 
 	for {
-		var removed [][2]int
-
-		for y := range grid {
-			for x := range grid[y] {
-				if grid[y][x] == '@' && neighRolls(x, y, grid) < 4 {
-					count++
-					removed = append(removed, [2]int{x, y})
-				}
+		var removed []aoc.Point
+
+		for pos, tile := range grid.All() {
+			if tile == '@' && neighRolls(pos, grid) < 4 {
+				count++
+				removed = append(removed, pos)
 			}
 		}
 
@@ -65,33 +73,19 @@ func PartTwo(grid [][]rune) int {
 		}
 
 		for _, pos := range removed {
-			x, y := pos[0], pos[1]
-			grid[y][x] = 'x'
+			grid.Set(pos, 'x')
 		}
 	}
 
 	return count
 }
 
-func neighRolls(x, y int, grid [][]rune) int {
+func neighRolls(pos aoc.Point, grid *aoc.Grid[rune]) int {
 	var count int
 
-	for dx := -1; dx <= 1; dx++ {
-		for dy := -1; dy <= 1; dy++ {
-			// home position
-			if dx == 0 && dy == 0 {
-				continue
-			}
-
-			// out of bounds
-			nx, ny := x+dx, y+dy
-			if nx < 0 || nx >= len(grid[0]) || ny < 0 || ny >= len(grid) {
-				continue
-			}
-
-			if grid[ny][nx] == '@' {
-				count++
-			}
+	for _, tile := range grid.Neighbours8(pos) {
+		if tile == '@' {
+			count++
 		}
 	}
 
@@ -0,0 +1,47 @@
+package day05
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(5, generate)
+}
+
+const (
+	// maxID is about the largest ingredient id of the real inputs
+	maxID = 500_000_000_000_000
+
+	maxSpan = 20_000_000_000_000
+)
+
+// generate writes size fresh ranges, which often overlap, followed by size
+// ingredient ids of which about half fall in a range
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	ranges := make([][2]int, size)
+	for i := range ranges {
+		lower := rng.IntN(maxID)
+		ranges[i] = [2]int{lower, lower + rng.IntN(maxSpan)}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, r := range ranges {
+		fmt.Fprintf(bw, "%d-%d\n", r[0], r[1])
+	}
+
+	bw.WriteByte('\n')
+	for range size {
+		id := rng.IntN(maxID + maxSpan)
+		if rng.IntN(2) == 0 {
+			r := ranges[rng.IntN(len(ranges))]
+			id = r[0] + rng.IntN(r[1]-r[0]+1)
+		}
+		fmt.Fprintf(bw, "%d\n", id)
+	}
+	return bw.Flush()
+}
@@ -1,43 +1,43 @@
-package main
+package day05
 
 import (
-	"flag"
-	"fmt"
-	"log"
+	"context"
+	"embed"
+	"io"
 	"slices"
 	"strconv"
-	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
-func ParseInput(input string) ([][2]int, []int, error) {
-	parts := strings.Split(input, "\n\n")
-	sRanges := strings.Split(strings.TrimSuffix(parts[0], "\n"), "\n")
-	sIngredients := strings.Split(strings.TrimSuffix(parts[1], "\n"), "\n")
+//go:embed examples
+var examples embed.FS
 
-	ranges := make([][2]int, len(sRanges))
-	for i, sRange := range sRanges {
-		parts := strings.Split(sRange, "-")
+var rangePattern = parse.MustCompile("{int}-{int}", nil)
 
-		lower, err := strconv.Atoi(parts[0])
-		if err != nil {
-			return nil, nil, err
-		}
+func ParseInput(input string) ([][2]int, []int, error) {
+	sections, err := aoc.Sections(input, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	sRanges, sIngredients := sections[0], sections[1]
 
-		upper, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, nil, err
+	ranges := make([][2]int, len(sRanges.Lines))
+	for i, sRange := range sRanges.Lines {
+		var lower, upper int
+		if err := rangePattern.Scan(sRange, &lower, &upper); err != nil {
+			return nil, nil, aoc.NewParseError(sRanges.Line+i, sRange, err)
 		}
 
 		ranges[i] = [2]int{lower, upper}
 	}
 
-	ingredients := make([]int, len(sIngredients))
-	for i, sIngredient := range sIngredients {
+	ingredients := make([]int, len(sIngredients.Lines))
+	for i, sIngredient := range sIngredients.Lines {
 		ingredient, err := strconv.Atoi(sIngredient)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, &aoc.ParseError{Line: sIngredients.Line + i, Column: 1, Text: sIngredient, Err: err}
 		}
 
 		ingredients[i] = ingredient
@@ -46,22 +46,31 @@ func ParseInput(input string) ([][2]int, []int, error) {
 	return ranges, ingredients, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	ranges      [][2]int
+	ingredients []int
+}
 
-	input, err := aoc.ReadInput(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(5, examples, func() aoc.Solver { return &solver{} })
+}
 
-	ranges, ingredients, err := ParseInput(input)
+func (s *solver) Parse(r io.Reader) error {
+	input, err := aoc.ReadString(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(ranges, ingredients))
-	fmt.Println("solution to part two: ", PartTwo(ranges))
+	s.ranges, s.ingredients, err = ParseInput(input)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.ranges, s.ingredients), nil
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.ranges), nil
 }
 
 func PartOne(ranges [][2]int, ingredients []int) int {
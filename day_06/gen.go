@@ -0,0 +1,63 @@
+package day06
+
+import (
+	"bufio"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(6, generate)
+}
+
+// numOperands is the number of rows of numbers in the real inputs
+const numOperands = 4
+
+// generate writes a worksheet of size problems. Like the real inputs, the
+// numbers of a problem are all aligned left or all aligned right within its
+// column, which matters for reading them right to left.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	rows := make([]strings.Builder, numOperands+1)
+
+	for i := range size {
+		nums := make([]string, numOperands)
+		var width int
+		for j := range nums {
+			nums[j] = strconv.Itoa(1 + rng.IntN(pow10(1+rng.IntN(4))-1))
+			width = max(width, len(nums[j]))
+		}
+
+		if i > 0 {
+			for j := range rows {
+				rows[j].WriteByte(' ')
+			}
+		}
+
+		left := rng.IntN(2) == 0
+		for j, num := range nums {
+			pad := strings.Repeat(" ", width-len(num))
+			if left {
+				rows[j].WriteString(num + pad)
+			} else {
+				rows[j].WriteString(pad + num)
+			}
+		}
+
+		op := "+"
+		if rng.IntN(2) == 0 {
+			op = "*"
+		}
+		rows[numOperands].WriteString(op + strings.Repeat(" ", width-1))
+	}
+
+	bw := bufio.NewWriter(w)
+	for i := range rows {
+		bw.WriteString(rows[i].String())
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
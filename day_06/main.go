@@ -1,54 +1,141 @@
-package main
+package day06
 
 import (
-	"flag"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
-	"log"
-	"strconv"
+	"io"
 	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
+)
+
+//go:embed examples
+var examples embed.FS
+
+var (
+	operandsPattern  = parse.MustCompile("{int...}", nil)
+	operatorsPattern = parse.MustCompile("{op...}", parse.Types{"op": parse.Func(parseOperator)})
 )
 
 func ParseInput(lines []string) ([][]int, []string, error) {
-	operators := strings.Fields(lines[len(lines)-1])
+	if len(lines) < 2 {
+		return nil, nil, fmt.Errorf("expected at least 2 lines, got %d", len(lines))
+	}
 
-	operands := make([][]int, len(lines)-1)
-	for i, line := range lines[:len(lines)-1] {
-		sNums := strings.Fields(line)
+	last := len(lines) - 1
 
-		nums := make([]int, len(sNums))
-		for i, sNum := range sNums {
-			num, err := strconv.Atoi(sNum)
-			if err != nil {
-				return nil, nil, err
-			}
+	var operators []string
+	if err := operatorsPattern.Scan(lines[last], &operators); err != nil {
+		return nil, nil, aoc.NewParseError(last+1, lines[last], err)
+	}
+
+	if len(operators) == 0 {
+		return nil, nil, aoc.NewParseError(last+1, lines[last], errors.New("no operators"))
+	}
 
-			nums[i] = num
+	operands := make([][]int, last)
+	for i, line := range lines[:last] {
+		var nums []int
+		if err := operandsPattern.Scan(line, &nums); err != nil {
+			return nil, nil, aoc.NewParseError(i+1, line, err)
+		}
+
+		if len(nums) != len(operators) {
+			err := fmt.Errorf("found %d numbers, expected one per operator (%d)", len(nums), len(operators))
+			return nil, nil, aoc.NewParseError(i+1, line, err)
 		}
 
 		operands[i] = nums
 	}
 
+	if err := checkColumns(lines[:last], len(operators)); err != nil {
+		return nil, nil, err
+	}
+
 	return operands, operators, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+// checkColumns makes sure part two can read the numbers column by column:
+// the rows of numbers must line up and hold only digits and spaces, and the
+// columns of spaces between them must split them into one problem per
+// operator
+func checkColumns(rows []string, numOperators int) error {
+	width := len(rows[0])
+	for i, row := range rows {
+		if len(row) != width {
+			err := fmt.Errorf("line has %d columns, expected %d", len(row), width)
+			return &aoc.ParseError{Line: i + 1, Column: min(len(row), width) + 1, Text: row, Err: err}
+		}
+	}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
-	if err != nil {
-		log.Fatal(err)
+	problems := 0
+	inProblem := false
+	for col := range width {
+		blank := true
+		for i, row := range rows {
+			switch {
+			case row[col] >= '0' && row[col] <= '9':
+				blank = false
+			case row[col] != ' ':
+				err := fmt.Errorf("invalid character %q, expected a digit or a space", row[col])
+				return &aoc.ParseError{Line: i + 1, Column: col + 1, Text: row, Err: err}
+			}
+		}
+
+		// a blank column must follow a problem and a problem must follow it
+		if blank && (!inProblem || col == width-1) {
+			err := errors.New("problems must be separated by exactly one column of spaces")
+			return &aoc.ParseError{Line: 1, Column: col + 1, Text: rows[0], Err: err}
+		}
+
+		if !blank && !inProblem {
+			problems++
+		}
+		inProblem = !blank
+	}
+
+	if problems != numOperators {
+		return fmt.Errorf("found %d problems, expected one per operator (%d)", problems, numOperators)
+	}
+	return nil
+}
+
+func parseOperator(raw string) (string, error) {
+	if raw != "+" && raw != "*" {
+		return "", fmt.Errorf("unknown operator %q", raw)
 	}
+	return raw, nil
+}
+
+type solver struct {
+	lines     []string
+	operands  [][]int
+	operators []string
+}
+
+func init() {
+	aoc.Register(6, examples, func() aoc.Solver { return &solver{} })
+}
 
-	operands, operators, err := ParseInput(lines)
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.lines, err = aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(operands, operators))
-	fmt.Println("solution to part two: ", PartTwo(lines))
+	s.operands, s.operators, err = ParseInput(s.lines)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.operands, s.operators), nil
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.lines), nil
 }
 
 func PartOne(operands [][]int, operators []string) int {
@@ -0,0 +1,47 @@
+package day07
+
+import (
+	"bufio"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(7, generate)
+}
+
+// generate writes a manifold 2*size+1 tiles wide and tall with the start in
+// the middle of the top row. Like the real inputs, splitters only sit on
+// every other row, within reach of the beam, and are never side by side.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	width := 2*size + 1
+	mid := size
+
+	bw := bufio.NewWriter(w)
+	row := make([]byte, width)
+	for y := range width {
+		for x := range row {
+			row[x] = '.'
+		}
+
+		switch {
+		case y == 0:
+			row[mid] = 'S'
+		case y%2 == 0:
+			// a beam only spreads one tile either way at each row of
+			// splitters
+			reach := y/2 - 1
+			for x := mid - reach; x <= mid+reach; x += 2 {
+				if rng.IntN(3) > 0 {
+					row[x] = '^'
+				}
+			}
+		}
+
+		bw.Write(row)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
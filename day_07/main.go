@@ -1,75 +1,105 @@
-package main
+package day07
 
 import (
-	"flag"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
 )
 
-const (
-	X, Y = 0, 1
-)
+//go:embed examples
+var examples embed.FS
 
-func ParseInput(lines []string) ([][]rune, [2]int) {
-	var start [2]int
-	grid := make([][]rune, len(lines))
+func ParseInput(lines []string) (*aoc.Grid[rune], aoc.Point, error) {
+	grid, err := aoc.ParseRuneGrid(lines)
+	if err != nil {
+		return nil, aoc.Point{}, err
+	}
 
-	for y := range lines {
-		grid[y] = []rune(lines[y])
-		for x := range lines[y] {
-			if grid[y][x] == 'S' {
-				start = [2]int{x, y}
-			}
+	for y, line := range lines {
+		if col := strings.IndexFunc(line, func(r rune) bool { return r != '.' && r != '^' && r != 'S' }); col >= 0 {
+			err := fmt.Errorf("invalid tile %q, expected ., ^ or S", []rune(line[col:])[0])
+			return nil, aoc.Point{}, &aoc.ParseError{Line: y + 1, Column: col + 1, Text: line, Err: err}
+		}
+
+		// a beam split off one splitter straight into another would bounce
+		// between them forever
+		if col := strings.Index(line, "^^"); col >= 0 {
+			err := errors.New("splitters side by side")
+			return nil, aoc.Point{}, &aoc.ParseError{Line: y + 1, Column: col + 2, Text: line, Err: err}
 		}
 	}
 
-	return grid, start
+	starts := grid.FindAll(func(tile rune) bool { return tile == 'S' })
+	if len(starts) == 0 {
+		return nil, aoc.Point{}, errors.New("missing start S")
+	}
+
+	if len(starts) > 1 {
+		dup := starts[1]
+		err := fmt.Errorf("duplicate start, first seen at line %d", starts[0].Y+1)
+		return nil, aoc.Point{}, &aoc.ParseError{Line: dup.Y + 1, Column: dup.X + 1, Text: lines[dup.Y], Err: err}
+	}
+
+	return grid, starts[0], nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	grid  *aoc.Grid[rune]
+	start aoc.Point
+}
+
+func init() {
+	aoc.Register(7, examples, func() aoc.Solver { return &solver{} })
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	grid, start := ParseInput(lines)
+	s.grid, s.start, err = ParseInput(lines)
+	return err
+}
 
-	fmt.Println("solution to part one: ", PartOne(grid, start))
-	fmt.Println("solution to part two: ", PartTwo(grid, start))
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.grid, s.start), nil
 }
 
-func PartOne(grid [][]rune, start [2]int) int {
-	seen := map[[2]int]bool{}
-	queue := [][2]int{start}
-	splits := map[[2]int]struct{}{}
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.grid, s.start), nil
+}
+
+func PartOne(grid *aoc.Grid[rune], start aoc.Point) int {
+	seen := map[aoc.Point]bool{}
+	queue := []aoc.Point{start}
+	splits := map[aoc.Point]struct{}{}
 
 	for len(queue) > 0 {
 		node := queue[0]
 		queue = queue[1:]
 
-		if seen[node] {
+		if seen[node] || !grid.In(node) {
 			continue
 		}
 
-		x, y := node[X], node[Y]
-		for ny := y; ny < len(grid); ny++ {
+		for pos := node; grid.In(pos); pos = pos.Add(aoc.Down) {
 			seen[node] = true
 
-			if grid[ny][x] != '^' {
+			if grid.At(pos) != '^' {
 				continue
 			}
 
 			// split node
-			xl, xr := x-1, x+1
-			queue = append(queue, [2]int{xl, ny})
-			queue = append(queue, [2]int{xr, ny})
+			queue = append(queue, pos.Add(aoc.Left))
+			queue = append(queue, pos.Add(aoc.Right))
 
-			splits[[2]int{x, ny}] = struct{}{}
+			splits[pos] = struct{}{}
 			break
 		}
 
@@ -78,33 +108,31 @@ func PartOne(grid [][]rune, start [2]int) int {
 	return len(splits)
 }
 
-func PartTwo(grid [][]rune, start [2]int) int {
-	memo := make(map[[2]int]int)
+func PartTwo(grid *aoc.Grid[rune], start aoc.Point) int {
+	memo := make(map[aoc.Point]int)
 	return timelines(grid, start, memo)
 }
 
-func timelines(grid [][]rune, start [2]int, memo map[[2]int]int) int {
-	x, y := start[X], start[Y]
+func timelines(grid *aoc.Grid[rune], start aoc.Point, memo map[aoc.Point]int) int {
+	// a beam split off the side of the manifold leaves it immediately
+	if !grid.In(start) {
+		return 1
+	}
 
-	for ny := y; ny < len(grid); ny++ {
-		if grid[ny][x] == '^' {
+	for pos := start; grid.In(pos); pos = pos.Add(aoc.Down) {
+		if grid.At(pos) == '^' {
 			// we've seen this split before
-			if branches, seen := memo[[2]int{x, ny}]; seen {
+			if branches, seen := memo[pos]; seen {
 				return branches
 			}
 
-			// l and r are always within bounds based on the puzzle input
-			lsplit := [2]int{x - 1, ny}
-			rsplit := [2]int{x + 1, ny}
+			ltimelines := timelines(grid, pos.Add(aoc.Left), memo)
+			rtimelines := timelines(grid, pos.Add(aoc.Right), memo)
 
-			ltimelines := timelines(grid, lsplit, memo)
-			rtimelines := timelines(grid, rsplit, memo)
-
-			memo[[2]int{x, ny}] = ltimelines + rtimelines
+			memo[pos] = ltimelines + rtimelines
 			return ltimelines + rtimelines
 		}
 	}
 
 	return 1
 }
-
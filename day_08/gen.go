@@ -0,0 +1,38 @@
+package day08
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(8, generate)
+}
+
+// generate writes size junction boxes at distinct positions within a 100000
+// unit cube, or if size is smaller as few as leave enough pairs to connect
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	// n boxes make n*(n-1)/2 pairs
+	n := max(size, minJBoxes)
+	for n*(n-1)/2 < connections {
+		n++
+	}
+
+	seen := make(map[[3]int]bool)
+
+	bw := bufio.NewWriter(w)
+	for len(seen) < n {
+		jbox := [3]int{rng.IntN(100_000), rng.IntN(100_000), rng.IntN(100_000)}
+		if seen[jbox] {
+			continue
+		}
+		seen[jbox] = true
+
+		fmt.Fprintf(bw, "%d,%d,%d\n", jbox[X], jbox[Y], jbox[Z])
+	}
+	return bw.Flush()
+}
@@ -1,35 +1,46 @@
-package main
+package day08
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
+	"io"
 	"math"
 	"slices"
-	"strconv"
-	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
+//go:embed examples
+var examples embed.FS
+
 const (
 	X = iota
 	Y
 	Z
 )
 
+var jboxPattern = parse.MustCompile("{int},{int},{int}", nil)
+
+// connections is how many of the closest pairs part one connects in the real
+// input, the example connects 10
+const connections = 1000
+
+// minJBoxes is the fewest junction boxes that still leave three circuits to
+// multiply
+const minJBoxes = 3
+
 func ParseInput(lines []string) ([][3]int, error) {
+	if len(lines) < minJBoxes {
+		return nil, fmt.Errorf("found %d junction boxes, expected at least %d", len(lines), minJBoxes)
+	}
+
 	jboxes := make([][3]int, len(lines))
 	for i := range lines {
-		dims := strings.Split(lines[i], ",")
-
 		var jbox [3]int
-		for j := range dims {
-			dim, err := strconv.Atoi(dims[j])
-			if err != nil {
-				return nil, err
-			}
-			jbox[j] = dim
+		if err := jboxPattern.Scan(lines[i], &jbox[X], &jbox[Y], &jbox[Z]); err != nil {
+			return nil, aoc.NewParseError(i+1, lines[i], err)
 		}
 
 		jboxes[i] = jbox
@@ -38,20 +49,57 @@ func ParseInput(lines []string) ([][3]int, error) {
 	return jboxes, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	connections int
+	jboxes      [][3]int
+	pairs       [][2]int
+}
+
+func init() {
+	aoc.Register(8, examples, func() aoc.Solver { return &solver{connections: connections} })
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
+func (s *solver) Configure(settings aoc.Settings) error {
+	for name, value := range settings {
+		if name != "connections" {
+			return fmt.Errorf("unknown setting %q", name)
+		}
+		if value < 0 {
+			return fmt.Errorf("connections = %d, expected at least 0", value)
+		}
+		s.connections = value
+	}
+	return nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	jboxes, err := ParseInput(lines)
+	s.jboxes, err = ParseInput(lines)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
+	s.pairs = sortedPairs(s.jboxes)
+	if len(s.pairs) < s.connections {
+		return fmt.Errorf("found %d pairs of junction boxes, expected at least %d to connect", len(s.pairs), s.connections)
+	}
+	return nil
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.jboxes, s.pairs, s.connections), nil
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.jboxes, s.pairs), nil
+}
+
+// sortedPairs returns every pair of jboxes sorted by distance ascending
+func sortedPairs(jboxes [][3]int) [][2]int {
 	// we could optimize this and preallocate nCr size
 	pairs := make([][2]int, 0, len(jboxes))
 	for i := 0; i < len(jboxes)-1; i++ {
@@ -74,11 +122,10 @@ func main() {
 		return 0
 	})
 
-	fmt.Println("solution to part one: ", PartOne(jboxes, pairs))
-	fmt.Println("solution to part two: ", PartTwo(jboxes, pairs))
+	return pairs
 }
 
-func PartOne(jboxes [][3]int, pairs [][2]int) int {
+func PartOne(jboxes [][3]int, pairs [][2]int, n int) int {
 	// we represent the sets using trees
 	// we use a slice to represent the tree
 	// such that the parent of node "i" is given by parents[i]
@@ -87,7 +134,6 @@ func PartOne(jboxes [][3]int, pairs [][2]int) int {
 		parents[i] = i
 	}
 
-	n := 1000
 	for i := range n {
 		pair := pairs[i]
 		merge(parents, pair[0], pair[1])
@@ -124,8 +170,6 @@ func PartTwo(jboxes [][3]int, pairs [][2]int) int {
 		}
 	}
 
-	fmt.Println(circuits)
-
 	return -1
 }
 
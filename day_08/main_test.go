@@ -0,0 +1,19 @@
+package day08
+
+import (
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 8)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 8)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 8)
+}
@@ -0,0 +1,169 @@
+package day09
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/difftest"
+)
+
+// TestPartTwoDifferential checks the coordinate compression of part two
+// against testing every tile of every rectangle
+func TestPartTwoDifferential(t *testing.T) {
+	difftest.Run(t, difftest.Check[[][2]int]{
+		// small gaps between coordinates make for narrow gaps in the polygon
+		Generate:  func(rng *rand.Rand, size int) [][2]int { return randomPolygon(rng, size, 4*size+8) },
+		MaxSize:   12,
+		Valid:     isSimpleRectilinear,
+		Reference: largestInside,
+		Optimised: PartTwo,
+		Shrink:    shrinkPolygon,
+		Format: func(corners [][2]int) string {
+			var sb strings.Builder
+			for _, c := range corners {
+				fmt.Fprintf(&sb, "%d,%d\n", c[X], c[Y])
+			}
+			return sb.String()
+		},
+	})
+}
+
+// largestInside returns the area of the largest rectangle with red corners
+// whose every tile is on or inside the polygon
+func largestInside(corners [][2]int) int {
+	var maxArea int
+	for i := range corners {
+		for j := i + 1; j < len(corners); j++ {
+			a, b := corners[i], corners[j]
+			if !rectangleInside(corners, a, b) {
+				continue
+			}
+			dx := max(a[X], b[X]) - min(a[X], b[X]) + 1
+			dy := max(a[Y], b[Y]) - min(a[Y], b[Y]) + 1
+			maxArea = max(maxArea, dx*dy)
+		}
+	}
+	return maxArea
+}
+
+func rectangleInside(corners [][2]int, a, b [2]int) bool {
+	for y := min(a[Y], b[Y]); y <= max(a[Y], b[Y]); y++ {
+		for x := min(a[X], b[X]); x <= max(a[X], b[X]); x++ {
+			if !tileInside(corners, [2]int{x, y}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// tileInside reports whether tile p is on the polygon's edges or inside it
+func tileInside(corners [][2]int, p [2]int) bool {
+	var crossings int
+	for i := range corners {
+		a, b := corners[i], corners[(i+1)%len(corners)]
+		if onSegment(p, a, b) {
+			return true
+		}
+
+		// count the vertical edges crossed by a ray from p to the right,
+		// treating each edge as half open so corners aren't counted twice
+		if a[X] == b[X] && a[X] > p[X] && min(a[Y], b[Y]) <= p[Y] && p[Y] < max(a[Y], b[Y]) {
+			crossings++
+		}
+	}
+	return crossings%2 == 1
+}
+
+func onSegment(p, a, b [2]int) bool {
+	return min(a[X], b[X]) <= p[X] && p[X] <= max(a[X], b[X]) &&
+		min(a[Y], b[Y]) <= p[Y] && p[Y] <= max(a[Y], b[Y])
+}
+
+// isSimpleRectilinear reports whether corners form a polygon whose edges
+// alternate between horizontal and vertical and never cross or touch other
+// than at shared corners
+func isSimpleRectilinear(corners [][2]int) bool {
+	n := len(corners)
+	if n < 4 || n%2 == 1 {
+		return false
+	}
+
+	for i := range corners {
+		prev, curr, next := corners[(i+n-1)%n], corners[i], corners[(i+1)%n]
+		if curr == next {
+			return false
+		}
+		// every corner turns
+		if (prev[X] == curr[X]) == (curr[X] == next[X]) || (prev[Y] == curr[Y]) == (curr[Y] == next[Y]) {
+			return false
+		}
+	}
+
+	for i := range corners {
+		for j := i + 2; j < n; j++ {
+			// the first and last edges are neighbours too
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if segmentsTouch(corners[i], corners[(i+1)%n], corners[j], corners[(j+1)%n]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// segmentsTouch reports whether two axis aligned segments share a point
+func segmentsTouch(a, b, c, d [2]int) bool {
+	return max(min(a[X], b[X]), min(c[X], d[X])) <= min(max(a[X], b[X]), max(c[X], d[X])) &&
+		max(min(a[Y], b[Y]), min(c[Y], d[Y])) <= min(max(a[Y], b[Y]), max(c[Y], d[Y]))
+}
+
+// shrinkPolygon yields the polygon without each unused row and column, which
+// moves everything beyond it one tile closer, and without each pair of
+// neighbouring corners
+func shrinkPolygon(corners [][2]int) iter.Seq[[][2]int] {
+	return func(yield func([][2]int) bool) {
+		for axis := range 2 {
+			used := make(map[int]bool)
+			var highest int
+			for _, c := range corners {
+				used[c[axis]] = true
+				highest = max(highest, c[axis])
+			}
+
+			for line := range highest {
+				if used[line] {
+					continue
+				}
+
+				smaller := slices.Clone(corners)
+				for i := range smaller {
+					if smaller[i][axis] > line {
+						smaller[i][axis]--
+					}
+				}
+				if !yield(smaller) {
+					return
+				}
+			}
+		}
+
+		for i := range corners {
+			smaller := slices.Clone(corners)
+			if i == len(corners)-1 {
+				smaller = smaller[1 : len(smaller)-1]
+			} else {
+				smaller = slices.Delete(smaller, i, i+2)
+			}
+			if !yield(smaller) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package day09
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(9, generate)
+}
+
+// generate writes the corners of a random rectilinear simple polygon with
+// coordinates as large as the real inputs
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+	for _, corner := range randomPolygon(rng, size, 100_000) {
+		fmt.Fprintf(bw, "%d,%d\n", corner[X], corner[Y])
+	}
+	return bw.Flush()
+}
+
+// randomPolygon returns the corners of a random rectilinear simple polygon in
+// clockwise order.
+//
+// The polygon starts as a blob of size cells grown one neighbour at a time
+// on a small grid. Cells that would only touch the blob at a corner are
+// skipped and holes are filled in, so its outline never crosses or touches
+// itself. The outline is then traced and its corners are spread out over
+// coordinates up to about extent.
+func randomPolygon(rng *rand.Rand, size, extent int) [][2]int {
+	cells := growBlob(rng, max(size, 1))
+
+	// the blob can't reach further than size cells from the origin, the
+	// extra ring keeps the outside connected for filling holes
+	n := 2*max(size, 1) + 3
+	fillHoles(cells, n)
+
+	xs := spread(rng, n+1, extent)
+	ys := spread(rng, n+1, extent)
+
+	var corners [][2]int
+	for _, c := range outline(cells) {
+		corners = append(corners, [2]int{xs[c.X], ys[c.Y]})
+	}
+	return corners
+}
+
+// growBlob returns a connected set of size cells around the centre of a
+// 2*size+3 grid where no two cells touch only at a corner
+func growBlob(rng *rand.Rand, size int) map[aoc.Point]bool {
+	centre := aoc.Point{X: size + 1, Y: size + 1}
+	cells := map[aoc.Point]bool{centre: true}
+	frontier := []aoc.Point{centre}
+
+	for len(cells) < size {
+		from := frontier[rng.IntN(len(frontier))]
+		next := from.Add(aoc.Cardinals[rng.IntN(len(aoc.Cardinals))])
+		if cells[next] || pinches(cells, next) {
+			continue
+		}
+
+		cells[next] = true
+		frontier = append(frontier, next)
+	}
+
+	return cells
+}
+
+// pinches reports whether adding cell p would leave it touching the blob
+// diagonally without sharing either of the sides in between
+func pinches(cells map[aoc.Point]bool, p aoc.Point) bool {
+	for _, dx := range []int{-1, 1} {
+		for _, dy := range []int{-1, 1} {
+			diag := aoc.Point{X: p.X + dx, Y: p.Y + dy}
+			side1 := aoc.Point{X: p.X + dx, Y: p.Y}
+			side2 := aoc.Point{X: p.X, Y: p.Y + dy}
+			if cells[diag] && !cells[side1] && !cells[side2] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fillHoles adds every cell of the n by n grid that can't be reached from its
+// border without crossing the blob
+func fillHoles(cells map[aoc.Point]bool, n int) {
+	outside := map[aoc.Point]bool{{X: 0, Y: 0}: true}
+	queue := []aoc.Point{{X: 0, Y: 0}}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, d := range aoc.Cardinals {
+			next := curr.Add(d)
+			if next.X < 0 || next.Y < 0 || next.X >= n || next.Y >= n {
+				continue
+			}
+			if cells[next] || outside[next] {
+				continue
+			}
+			outside[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	for y := range n {
+		for x := range n {
+			if p := (aoc.Point{X: x, Y: y}); !outside[p] {
+				cells[p] = true
+			}
+		}
+	}
+}
+
+// outline returns the corners of the blob's boundary in clockwise order. Cell
+// (x, y) spans the grid points (x, y) to (x+1, y+1).
+func outline(cells map[aoc.Point]bool) []aoc.Point {
+	// every side of a cell facing outside is an edge of the boundary, directed
+	// so the blob is on its right
+	next := make(map[aoc.Point]aoc.Point)
+	for c := range cells {
+		if !cells[c.Add(aoc.Up)] {
+			next[c] = aoc.Point{X: c.X + 1, Y: c.Y}
+		}
+		if !cells[c.Add(aoc.Right)] {
+			next[aoc.Point{X: c.X + 1, Y: c.Y}] = aoc.Point{X: c.X + 1, Y: c.Y + 1}
+		}
+		if !cells[c.Add(aoc.Down)] {
+			next[aoc.Point{X: c.X + 1, Y: c.Y + 1}] = aoc.Point{X: c.X, Y: c.Y + 1}
+		}
+		if !cells[c.Add(aoc.Left)] {
+			next[aoc.Point{X: c.X, Y: c.Y + 1}] = c
+		}
+	}
+
+	// start from the top left of the topmost, leftmost cell, which is always
+	// a corner
+	var start aoc.Point
+	first := true
+	for p := range next {
+		if first || p.Y < start.Y || (p.Y == start.Y && p.X < start.X) {
+			start, first = p, false
+		}
+	}
+
+	var corners []aoc.Point
+	prev := start
+	for p := start; ; {
+		q := next[p]
+		// keep only the points where the boundary turns
+		if (prev.X == p.X) != (p.X == q.X) || p == start {
+			corners = append(corners, p)
+		}
+		prev, p = p, q
+		if p == start {
+			break
+		}
+	}
+
+	return corners
+}
+
+// spread returns n increasing coordinates up to about extent with random
+// gaps, so that corners on neighbouring grid lines can be far apart or right
+// next to each other
+func spread(rng *rand.Rand, n, extent int) []int {
+	coords := make([]int, n)
+	coords[0] = rng.IntN(extent/100 + 1)
+	for i := 1; i < n; i++ {
+		coords[i] = coords[i-1] + 1 + rng.IntN(extent/n+1)
+	}
+	return coords
+}
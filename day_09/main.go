@@ -1,56 +1,76 @@
-package main
+package day09
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
+	"io"
 	"sort"
-	"strconv"
-	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
+//go:embed examples
+var examples embed.FS
+
 const (
 	X, Y = 0, 1
 )
 
+var cornerPattern = parse.MustCompile("{int},{int}", nil)
+
 func ParseInput(lines []string) ([][2]int, error) {
 	corners := make([][2]int, len(lines))
 	for i := range lines {
-		parts := strings.Split(lines[i], ",")
-
-		x, err := strconv.Atoi(parts[0])
-		if err != nil {
-			return nil, err
-		}
-
-		y, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, err
+		var x, y int
+		if err := cornerPattern.Scan(lines[i], &x, &y); err != nil {
+			return nil, aoc.NewParseError(i+1, lines[i], err)
 		}
 
 		corners[i] = [2]int{x, y}
 	}
+
+	// each corner is joined to the next by a straight line of tiles, and the
+	// last corner to the first
+	for i := range corners {
+		prev := corners[(i+len(corners)-1)%len(corners)]
+		if corners[i][X] != prev[X] && corners[i][Y] != prev[Y] {
+			err := fmt.Errorf("corner shares neither a row nor a column with the previous corner %d,%d", prev[X], prev[Y])
+			if i == 0 {
+				err = fmt.Errorf("corner shares neither a row nor a column with the last corner %d,%d", prev[X], prev[Y])
+			}
+			return nil, aoc.NewParseError(i+1, lines[i], err)
+		}
+	}
+
 	return corners, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	corners [][2]int
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(9, examples, func() aoc.Solver { return &solver{} })
+}
 
-	corners, err := ParseInput(lines)
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(corners))
-	fmt.Println("solution to part two: ", PartTwo(corners))
+	s.corners, err = ParseInput(lines)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.corners), nil
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.corners), nil
 }
 
 func PartOne(corners [][2]int) int {
@@ -94,86 +114,55 @@ func PartTwo(redTiles [][2]int) int {
 	sort.Ints(ys)
 
 	// lookup to translate  real coordiantes to compressed coordinates
-	xlookup := make(map[int]int)
-	ylookup := make(map[int]int)
+	xlookup, xhollow := compress(xs)
+	ylookup, yhollow := compress(ys)
 
-	for i := range len(ys) {
-		ylookup[ys[i]] = i
-	}
-
-	for i := range len(xs) {
-		xlookup[xs[i]] = i
-	}
-
-	grid := make([][]rune, len(ys))
-	for i := range grid {
-		grid[i] = []rune(strings.Repeat(".", len(xs)))
-	}
+	// we add a padding of one tile around the compressed grid so that every
+	// tile outside the polygon is reachable from the top left corner
+	grid := aoc.NewGrid(len(xhollow), len(yhollow), '.')
 
 	// build compressed grid
 	for i := range redTiles {
 		a, b := redTiles[i], redTiles[(i+1)%len(redTiles)]
 
 		// compressed coordinates of tile a and b
-		cax, cay := xlookup[a[X]], ylookup[a[Y]]
-		cbx, cby := xlookup[b[X]], ylookup[b[Y]]
+		ca := aoc.Point{X: xlookup[a[X]], Y: ylookup[a[Y]]}
+		cb := aoc.Point{X: xlookup[b[X]], Y: ylookup[b[Y]]}
 
 		// mark current tile (a) as red
-		grid[cay][cax] = '#'
+		grid.Set(ca, '#')
 
 		// mark all tiles between a and b as green
 		// NOTE: adjacent tiles in the input are either on the same row or column
-		if cay-cby == 0 {
+		if ca.Y == cb.Y {
 			// tile a & b are on the same row
-			for x := min(cax, cbx) + 1; x < max(cax, cbx); x++ {
-				grid[cay][x] = 'X'
+			for x := min(ca.X, cb.X) + 1; x < max(ca.X, cb.X); x++ {
+				grid.Set(aoc.Point{X: x, Y: ca.Y}, 'X')
 			}
 		} else {
 			// tile a & b are on the same column
-			for y := min(cay, cby) + 1; y < max(cay, cby); y++ {
-				grid[y][cax] = 'X'
+			for y := min(ca.Y, cb.Y) + 1; y < max(ca.Y, cb.Y); y++ {
+				grid.Set(aoc.Point{X: ca.X, Y: y}, 'X')
 			}
 		}
 	}
 
-	// keep a set of all points outside the polygon
-	outside := make(map[[2]int]struct{})
-	queue := [][2]int{{-1, -1}} // we add an imaginary padding around the grid
+	// flood fill all tiles outside the polygon, marking them with 'O'
+	queue := []aoc.Point{{X: 0, Y: 0}}
+	grid.Set(queue[0], 'O')
 
 	for len(queue) > 0 {
 		curr := queue[0]
 		queue = queue[1:]
 
-		x, y := curr[X], curr[Y]
-
-		// bounds check factoring in the imaginary padding
-		if x < -1 || x > len(grid[0]) || y < -1 || y > len(grid) {
-			continue
-		}
-
-		// for non-padding tiles, skip red/green tiles
-		if x >= 0 && x < len(grid[0]) && y >= 0 && y < len(grid) {
-			if grid[y][x] == 'X' || grid[y][x] == '#' {
+		for next, tile := range grid.Neighbours4(curr) {
+			// skip red/green tiles and tiles we've already seen
+			if tile != '.' {
 				continue
 			}
-		}
 
-		if _, seen := outside[curr]; seen {
-			continue
-		}
-
-		outside[curr] = struct{}{}
-
-		cards := [][2]int{
-			{-1, 0},
-			{1, 0},
-			{0, -1},
-			{0, 1},
-		}
-
-		for _, card := range cards {
-			nx, ny := x+card[X], y+card[Y]
-			queue = append(queue, [2]int{nx, ny})
+			grid.Set(next, 'O')
+			queue = append(queue, next)
 		}
 	}
 
@@ -186,10 +175,10 @@ func PartTwo(redTiles [][2]int) int {
 			dy := (max(a[Y], b[Y]) - min(a[Y], b[Y]) + 1)
 
 			// verify that all grid points are within the polygon
-			cax, cay := xlookup[a[X]], ylookup[a[Y]]
-			cbx, cby := xlookup[b[X]], ylookup[b[Y]]
+			ca := aoc.Point{X: xlookup[a[X]], Y: ylookup[a[Y]]}
+			cb := aoc.Point{X: xlookup[b[X]], Y: ylookup[b[Y]]}
 
-			if !isAreaWithinPolygon([2]int{cax, cay}, [2]int{cbx, cby}, outside) {
+			if !isAreaWithinPolygon(ca, cb, grid, xhollow, yhollow) {
 				continue
 			}
 
@@ -200,13 +189,32 @@ func PartTwo(redTiles [][2]int) int {
 	return maxArea
 }
 
-func isAreaWithinPolygon(a, b [2]int, outside map[[2]int]struct{}) bool {
-	cax, cay := a[X], a[Y]
-	cbx, cby := b[X], b[Y]
+// compress maps sorted coordinates to every other compressed coordinate,
+// starting at 1 to leave room for the padding. The cells in between stand for
+// the space between neighbouring coordinates, so that gaps outside the polygon
+// don't vanish, and hollow marks those that hold no tiles at all.
+func compress(coords []int) (map[int]int, []bool) {
+	lookup := make(map[int]int, len(coords))
+	hollow := make([]bool, 2*len(coords)+1)
+	for i, c := range coords {
+		lookup[c] = 2*i + 1
+		if i > 0 {
+			hollow[2*i] = c == coords[i-1]+1
+		}
+	}
+	return lookup, hollow
+}
+
+func isAreaWithinPolygon(a, b aoc.Point, grid *aoc.Grid[rune], xhollow, yhollow []bool) bool {
+	for y := min(a.Y, b.Y); y <= max(a.Y, b.Y); y++ {
+		for x := min(a.X, b.X); x <= max(a.X, b.X); x++ {
+			// the gap between neighbouring tiles can be outside while they
+			// aren't
+			if xhollow[x] || yhollow[y] {
+				continue
+			}
 
-	for y := min(cay, cby); y <= max(cay, cby); y++ {
-		for x := min(cax, cbx); x <= max(cax, cbx); x++ {
-			if _, exists := outside[[2]int{x, y}]; exists {
+			if grid.At(aoc.Point{X: x, Y: y}) == 'O' {
 				return false
 			}
 		}
@@ -0,0 +1,55 @@
+package day09
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 9)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 9)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 9)
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		line  int // line of the ParseError, 0 if the lines parse
+	}{
+		{name: "square", lines: []string{"1,1", "5,1", "5,5", "1,5"}},
+		{name: "single corner", lines: []string{"1,1"}},
+		{name: "diagonal edge", lines: []string{"1,1", "5,1", "7,5", "1,5"}, line: 3},
+		{name: "diagonal wrap", lines: []string{"1,1", "5,1", "5,5", "2,5"}, line: 1},
+		{name: "not a corner", lines: []string{"1,1", "5;1"}, line: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseInput(test.lines)
+			if test.line == 0 {
+				if err != nil {
+					t.Errorf("ParseInput() = %v, want no error", err)
+				}
+				return
+			}
+
+			var perr *aoc.ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseInput() = %v, want a *aoc.ParseError", err)
+			}
+			if perr.Line != test.line {
+				t.Errorf("error on line %d, want %d: %v", perr.Line, test.line, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package day10
+
+import (
+	"context"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/difftest"
+)
+
+// TestSolveDifferential checks the parity halving of solve against trying
+// every number of presses of every button, including on machines whose
+// joltage can't be reached
+func TestSolveDifferential(t *testing.T) {
+	difftest.Run(t, difftest.Check[machine]{
+		Generate:  smallMachine,
+		MaxSize:   4,
+		Reference: fewestPresses,
+		Optimised: func(m machine) int {
+			presses, ok, err := solve(context.Background(), m.joltage, patterns(m))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				return -1
+			}
+			return presses
+		},
+		Shrink: shrinkMachine,
+		Format: func(m machine) string {
+			var sb strings.Builder
+			writeMachine(&sb, m)
+			return sb.String()
+		},
+	})
+}
+
+// smallMachine returns a machine with up to size+1 lights and size+2 buttons
+// whose joltage is reached by pressing each button up to 2*size times, apart
+// from one in four machines that need one more on a random light, which is
+// often out of reach. Only part two is checked so the lights are left off.
+func smallMachine(rng *rand.Rand, size int) machine {
+	numLights := 1 + rng.IntN(size+1)
+	m := machine{numLights: numLights, joltage: make([]int, numLights)}
+
+	for range 1 + rng.IntN(size+2) {
+		button := rng.Perm(numLights)[:1+rng.IntN(numLights)]
+		slices.Sort(button)
+		m.buttons = append(m.buttons, button)
+
+		presses := rng.IntN(2*size + 1)
+		for _, light := range button {
+			m.joltage[light] += presses
+		}
+	}
+
+	if rng.IntN(4) == 0 {
+		m.joltage[rng.IntN(numLights)]++
+	}
+
+	return m
+}
+
+// fewestPresses returns the fewest presses that reach the joltage of m, or -1
+// if it can't be reached, by trying every number of presses of every button
+func fewestPresses(m machine) int {
+	// once the last button wiring a light has been decided its joltage must
+	// have been reached
+	lastButton := make([]int, m.numLights)
+	for light := range lastButton {
+		lastButton[light] = -1
+	}
+	for i, button := range m.buttons {
+		for _, light := range button {
+			lastButton[light] = i
+		}
+	}
+
+	remaining := slices.Clone(m.joltage)
+	settled := func(button int) bool {
+		for light, last := range lastButton {
+			if last == button && remaining[light] != 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	best := -1
+	var search func(button, presses int)
+	search = func(button, presses int) {
+		if best >= 0 && presses >= best {
+			return
+		}
+
+		if button == len(m.buttons) {
+			best = presses
+			return
+		}
+
+		// pressing any more would overshoot one of the button's lights
+		limit := math.MaxInt
+		for _, light := range m.buttons[button] {
+			limit = min(limit, remaining[light])
+		}
+
+		for n := range limit + 1 {
+			for _, light := range m.buttons[button] {
+				remaining[light] -= n
+			}
+
+			if settled(button) {
+				search(button+1, presses+n)
+			}
+
+			for _, light := range m.buttons[button] {
+				remaining[light] += n
+			}
+		}
+	}
+
+	if settled(-1) {
+		search(0, 0)
+	}
+	return best
+}
+
+// shrinkMachine yields m without each of its buttons, with each joltage one
+// lower and without each of its lights
+func shrinkMachine(m machine) iter.Seq[machine] {
+	return func(yield func(machine) bool) {
+		for i := range m.buttons {
+			smaller := m
+			smaller.buttons = slices.Delete(slices.Clone(m.buttons), i, i+1)
+			if !yield(smaller) {
+				return
+			}
+		}
+
+		for light := range m.joltage {
+			if m.joltage[light] == 0 {
+				continue
+			}
+			smaller := m
+			smaller.joltage = slices.Clone(m.joltage)
+			smaller.joltage[light]--
+			if !yield(smaller) {
+				return
+			}
+		}
+
+		for light := range m.numLights {
+			if m.numLights == 1 {
+				break
+			}
+			if !yield(withoutLight(m, light)) {
+				return
+			}
+		}
+	}
+}
+
+// withoutLight removes a light from m and from the buttons wiring it,
+// dropping buttons left wiring nothing
+func withoutLight(m machine, light int) machine {
+	smaller := machine{
+		numLights: m.numLights - 1,
+		joltage:   slices.Delete(slices.Clone(m.joltage), light, light+1),
+	}
+
+	for _, button := range m.buttons {
+		var wired []int
+		for _, l := range button {
+			switch {
+			case l < light:
+				wired = append(wired, l)
+			case l > light:
+				wired = append(wired, l-1)
+			}
+		}
+		if len(wired) > 0 {
+			smaller.buttons = append(smaller.buttons, wired)
+		}
+	}
+
+	return smaller
+}
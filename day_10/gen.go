@@ -0,0 +1,84 @@
+package day10
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(10, generate)
+}
+
+// maxLightPresses bounds the presses needed to light up a generated machine,
+// keeping the breadth first search of part one small
+const maxLightPresses = 4
+
+// generate writes size machines with 4 to 10 lights and 3 to 13 buttons, like
+// the real inputs. Both parts are solvable for every machine: the lights are
+// what pressing a few of the buttons once gives and the joltage is what
+// pressing each button a random number of times gives.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+	for range size {
+		numLights := 4 + rng.IntN(7)
+
+		buttons := make([][]int, 3+rng.IntN(numLights+1))
+		for i := range buttons {
+			button := rng.Perm(numLights)[:1+rng.IntN(min(numLights, 6))]
+			slices.Sort(button)
+			buttons[i] = button
+		}
+
+		m := machine{numLights: numLights, buttons: buttons, joltage: make([]int, numLights)}
+		masks := m.buttonmMasks()
+		for m.lightMask == 0 {
+			for _, i := range rng.Perm(len(buttons))[:1+rng.IntN(min(len(buttons), maxLightPresses))] {
+				m.lightMask ^= masks[i]
+			}
+		}
+
+		for _, button := range buttons {
+			presses := rng.IntN(20)
+			for _, light := range button {
+				m.joltage[light] += presses
+			}
+		}
+
+		writeMachine(bw, m)
+	}
+	return bw.Flush()
+}
+
+// writeMachine writes m in the input format
+func writeMachine(w io.Writer, m machine) {
+	lights := make([]byte, m.numLights)
+	for i := range lights {
+		lights[i] = '.'
+		if m.lightMask&(1<<(m.numLights-i-1)) != 0 {
+			lights[i] = '#'
+		}
+	}
+
+	fields := []string{"[" + string(lights) + "]"}
+	for _, button := range m.buttons {
+		fields = append(fields, "("+joinInts(button)+")")
+	}
+	fields = append(fields, "{"+joinInts(m.joltage)+"}")
+
+	fmt.Fprintln(w, strings.Join(fields, " "))
+}
+
+func joinInts(nums []int) string {
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.Itoa(n)
+	}
+	return strings.Join(strs, ",")
+}
@@ -1,16 +1,21 @@
-package main
+package day10
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
+	"io"
+	"math/bits"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
+//go:embed examples
+var examples embed.FS
+
 type machine struct {
 	lightMask int // bit representation of lights e.g [#..] = 100
 	numLights int // number of lights
@@ -31,118 +36,183 @@ func (m machine) buttonmMasks() []int {
 	return masks
 }
 
+const (
+	// maxLights is the most lights a machine can have, one per bit of the
+	// masks they are kept in
+	maxLights = 63
+
+	// maxButtons bounds the buttons of a machine, as part two works out what
+	// every combination of them presses
+	maxButtons = 16
+)
+
+var machinePattern = parse.MustCompile("[{lights}] {button...} {{{ints}}}", parse.Types{
+	"lights": parse.Func(validateLights),
+	"button": parse.Func(parseButton),
+})
+
 func ParseInput(lines []string) ([]machine, error) {
 	machines := make([]machine, len(lines))
 	for i, line := range lines {
-		parts := strings.Fields(line)
+		var rawLights string
+		var buttons [][]int
+		var joltage []int
 
-		rawLights := parts[0]
-		rawJoltage := parts[len(parts)-1]
-		rawButtons := parts[1 : len(parts)-1]
+		if err := machinePattern.Scan(line, &rawLights, &buttons, &joltage); err != nil {
+			return nil, aoc.NewParseError(i+1, line, err)
+		}
 
-		lightMask := parseLights(rawLights)
+		if len(rawLights) > maxLights {
+			err := fmt.Errorf("found %d lights, expected at most %d", len(rawLights), maxLights)
+			return nil, aoc.NewParseError(i+1, line, err)
+		}
 
-		joltage, err := parseJoltage(rawJoltage)
-		if err != nil {
-			return nil, err
+		if len(buttons) > maxButtons {
+			err := fmt.Errorf("found %d buttons, expected at most %d", len(buttons), maxButtons)
+			return nil, aoc.NewParseError(i+1, line, err)
 		}
 
-		buttons, err := parseButtons(rawButtons)
-		if err != nil {
-			return nil, err
+		if len(joltage) != len(rawLights) {
+			err := fmt.Errorf("found %d joltage requirements for %d lights", len(joltage), len(rawLights))
+			return nil, aoc.NewParseError(i+1, line, err)
 		}
 
-		machines[i] = machine{
+		for _, button := range buttons {
+			for _, light := range button {
+				if light < 0 || light >= len(rawLights) {
+					err := fmt.Errorf("button wires light %d but there are only %d lights", light, len(rawLights))
+					return nil, aoc.NewParseError(i+1, line, err)
+				}
+			}
+		}
+
+		m := machine{
 			joltage:   joltage,
-			lightMask: lightMask,
+			lightMask: parseLights(rawLights),
 			buttons:   buttons,
-			numLights: len(rawLights) - 2, // -2 to ignore the square brackets
+			numLights: len(rawLights),
 		}
+
+		// the search for the fewest presses would never end
+		if !reachable(m.lightMask, m.buttonmMasks()) {
+			err := fmt.Errorf("no combination of buttons lights up [%s]", rawLights)
+			return nil, aoc.NewParseError(i+1, line, err)
+		}
+
+		machines[i] = m
 	}
 	return machines, nil
 }
 
-func parseLights(raw string) int {
-	// trim off square brackets
-	trimmed := strings.Trim(strings.Trim(raw, "["), "]")
+// reachable reports whether pressing some of the buttons once each turns on
+// exactly the lights in target
+func reachable(target int, buttonMasks []int) bool {
+	// reduce target by a basis of the button masks, indexed by their highest
+	// light, so that it only vanishes if it is a combination of them
+	basis := make(map[int]int)
+	reduce := func(mask int) int {
+		for mask != 0 {
+			high := bits.Len(uint(mask)) - 1
+			b, ok := basis[high]
+			if !ok {
+				break
+			}
+			mask ^= b
+		}
+		return mask
+	}
 
+	for _, mask := range buttonMasks {
+		if mask = reduce(mask); mask != 0 {
+			basis[bits.Len(uint(mask))-1] = mask
+		}
+	}
+
+	return reduce(target) == 0
+}
+
+func validateLights(raw string) (string, error) {
+	if raw == "" || strings.Trim(raw, ".#") != "" {
+		return "", fmt.Errorf("lights must only contain . and #, got %q", raw)
+	}
+	return raw, nil
+}
+
+func parseLights(raw string) int {
 	//.##. = 0110
 	var lights int
-	for i, v := range trimmed {
+	for i, v := range raw {
 		// state is either 0 or 1 i.e on/off
 		var state int
 		if v == '#' {
 			state = 1
 		}
-		lights += state << (len(trimmed) - 1 - i)
+		lights += state << (len(raw) - 1 - i)
 	}
 
 	return lights
 }
 
-func parseButtons(rawButtons []string) ([][]int, error) {
-	buttons := make([][]int, len(rawButtons))
-	for i, rawButton := range rawButtons {
-		trimmed := strings.Trim(strings.Trim(rawButton, "("), ")")
-
-		var button []int
-		for _, lightStr := range strings.Split(trimmed, ",") {
-			light, err := strconv.Atoi(lightStr)
-			if err != nil {
-				return nil, err
-			}
-			button = append(button, light)
-		}
-
-		buttons[i] = button
+func parseButton(raw string) ([]int, error) {
+	inner, ok := strings.CutPrefix(raw, "(")
+	if ok {
+		inner, ok = strings.CutSuffix(inner, ")")
 	}
-	return buttons, nil
-}
-
-func parseJoltage(raw string) ([]int, error) {
-	trimmed := strings.Trim(strings.Trim(raw, "{"), "}")
-	parts := strings.Split(trimmed, ",")
-
-	joltage := make([]int, len(parts))
-	for i, joltStr := range parts {
-		jolt, err := strconv.Atoi(joltStr)
-		if err != nil {
-			return nil, err
-		}
-		joltage[i] = jolt
+	if !ok {
+		return nil, fmt.Errorf("button must be wrapped in parentheses, got %q", raw)
 	}
 
-	return joltage, nil
+	return parse.Ints(inner)
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	machines []machine
+}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(10, examples, func() aoc.Solver { return &solver{} })
+}
 
-	machines, err := ParseInput(lines)
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(machines))
-	fmt.Println("solution to part two: ", PartTwo(machines))
+	s.machines, err = ParseInput(lines)
+	return err
 }
 
-func PartOne(machines []machine) int {
+func (s *solver) PartOne(ctx context.Context) (int, error) {
+	return PartOne(ctx, s.machines)
+}
+
+func (s *solver) PartTwo(ctx context.Context) (int, error) {
+	return PartTwo(ctx, s.machines)
+}
+
+func PartOne(ctx context.Context, machines []machine) (int, error) {
 	var minSum int
 	for _, m := range machines {
-		presses := minPresses(m)
+		presses, err := minPresses(ctx, m)
+		if err != nil {
+			return 0, err
+		}
 		minSum += presses
 	}
-	return minSum
+	return minSum, nil
 }
 
-func minPresses(m machine) int {
+// cancelCheckInterval is how many queue entries the search visits between
+// looking for cancellation
+const cancelCheckInterval = 1 << 12
+
+func minPresses(ctx context.Context, m machine) (int, error) {
+	// the lights start off, so there is nothing to press
+	if m.lightMask == 0 {
+		return 0, nil
+	}
+
 	queue := [][3]int{}
 
 	// add initial nodes to queue
@@ -151,7 +221,11 @@ func minPresses(m machine) int {
 		queue = append(queue, [3]int{0, b, 0})
 	}
 
-	for len(queue) > 0 {
+	for visited := 0; len(queue) > 0; visited++ {
+		if visited%cancelCheckInterval == 0 && ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
 		curr := queue[0]
 		queue = queue[1:]
 
@@ -163,7 +237,7 @@ func minPresses(m machine) int {
 
 		// we've found the minimum presses
 		if lights == m.lightMask {
-			return presses
+			return presses, nil
 		}
 
 		// we want to simulate new presses
@@ -173,7 +247,7 @@ func minPresses(m machine) int {
 	}
 
 	// should be impossible to hit
-	return 0
+	return 0, nil
 }
 
 func buttonMask(button []int, numLights int) int {
@@ -184,13 +258,24 @@ func buttonMask(button []int, numLights int) int {
 	return mask
 }
 
-func PartTwo(machines []machine) int {
+func PartTwo(ctx context.Context, machines []machine) (int, error) {
 	var minSum int
 	// we solve each machine as simulataneous equations
-	for _, m := range machines {
-		minSum += solve(m.joltage, patterns(m))
+	for i, m := range machines {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		presses, ok, err := solve(ctx, m.joltage, patterns(m))
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, fmt.Errorf("machine %d: no button presses reach joltage %v", i+1, m.joltage)
+		}
+		minSum += presses
 	}
-	return minSum
+	return minSum, nil
 }
 
 func patterns(m machine) map[string][]buttonCombo {
@@ -218,15 +303,23 @@ func patterns(m machine) map[string][]buttonCombo {
 	return p
 }
 
-func solve(joltage []int, patterns map[string][]buttonCombo) int {
+// solve returns the fewest presses that reach joltage, and false if no
+// presses do. The search can branch widely for large joltages and gives up
+// with ctx.Err() once ctx is done.
+func solve(ctx context.Context, joltage []int, patterns map[string][]buttonCombo) (int, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, false, err
+	}
+
 	// base case: joltage is zeroed out e.g 0,0,0,0
 	if slices.Max(joltage) == 0 {
-		return 0
+		return 0, true, nil
 	}
 
 	key := joltageParityKey(joltage)
 
-	minPresses := 1_000_000
+	var minPresses int
+	var found bool
 	for _, combo := range patterns[key] {
 		newJoltage := slices.Clone(joltage)
 
@@ -240,11 +333,21 @@ func solve(joltage []int, patterns map[string][]buttonCombo) int {
 			continue
 		}
 
-		presses := 2*solve(newJoltage, patterns) + combo.presses
-		minPresses = min(minPresses, presses)
+		presses, ok, err := solve(ctx, newJoltage, patterns)
+		if err != nil {
+			return 0, false, err
+		}
+		if !ok {
+			continue
+		}
+
+		presses = 2*presses + combo.presses
+		if !found || presses < minPresses {
+			minPresses, found = presses, true
+		}
 	}
 
-	return minPresses
+	return minPresses, found, nil
 }
 
 func joltageParityKey(joltage []int) string {
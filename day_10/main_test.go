@@ -0,0 +1,64 @@
+package day10
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 10)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 10)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 10)
+}
+
+func TestPartTwoUnreachable(t *testing.T) {
+	// the second light has no button, so its joltage can't be raised
+	machines, err := ParseInput([]string{"[#.] (0) {1,1}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if presses, err := PartTwo(t.Context(), machines); err == nil {
+		t.Errorf("PartTwo() = %d, want an error", presses)
+	}
+}
+
+func TestParseInputLimits(t *testing.T) {
+	// a machine with the lights off, buttons wiring light 0 and no joltage
+	machine := func(lights, buttons int) string {
+		return "[" + strings.Repeat(".", lights) + "] " +
+			strings.Repeat("(0) ", buttons) +
+			"{" + strings.TrimSuffix(strings.Repeat("0,", lights), ",") + "}"
+	}
+
+	tests := []struct {
+		name    string
+		line    string
+		wantErr string // empty if the line parses
+	}{
+		{name: "most lights", line: machine(maxLights, 1)},
+		{name: "too many lights", line: machine(maxLights+1, 1), wantErr: "found 64 lights, expected at most 63"},
+		{name: "most buttons", line: machine(1, maxButtons)},
+		{name: "too many buttons", line: machine(1, maxButtons+1), wantErr: "found 17 buttons, expected at most 16"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseInput([]string{test.line})
+			switch {
+			case test.wantErr == "" && err != nil:
+				t.Errorf("ParseInput() = %v, want no error", err)
+			case test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)):
+				t.Errorf("ParseInput() = %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package day11
+
+import (
+	"bufio"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(11, generate)
+}
+
+// window is how far down the topological order a device's outputs may reach,
+// wide enough to keep the number of paths within an int
+const window = 40
+
+// generate writes size devices, or five if size is smaller, wired as a
+// directed acyclic graph. Every device leads to out, which has no line of its
+// own, and there are paths from svr through both fft and dac.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	n := max(size, 5)
+
+	// devices are numbered in topological order, svr first and out last
+	names := randomNames(rng, n+1)
+	names[0], names[n] = "svr", "out"
+
+	// the other named devices go anywhere in between
+	special := rng.Perm(n - 1)[:3]
+	for i, name := range []string{"fft", "dac", "you"} {
+		names[special[i]+1] = name
+	}
+
+	outputs := make([][]int, n)
+	connect := func(from, to int) {
+		if !slices.Contains(outputs[from], to) {
+			outputs[from] = append(outputs[from], to)
+		}
+	}
+
+	// make sure svr reaches out through fft and dac, in whichever order they
+	// came
+	waypoints := []int{0, special[0] + 1, special[1] + 1, n}
+	slices.Sort(waypoints)
+	for i := range len(waypoints) - 1 {
+		for curr := waypoints[i]; curr < waypoints[i+1]; {
+			next := curr + 1 + rng.IntN(min(window, waypoints[i+1]-curr))
+			connect(curr, next)
+			curr = next
+		}
+	}
+
+	for from := range n {
+		for range 1 + rng.IntN(3) {
+			connect(from, from+1+rng.IntN(min(window, n-from)))
+		}
+	}
+
+	lines := make([]string, n)
+	for from := range n {
+		slices.Sort(outputs[from])
+		outs := make([]string, len(outputs[from]))
+		for i, to := range outputs[from] {
+			outs[i] = names[to]
+		}
+		lines[from] = names[from] + ": " + strings.Join(outs, " ")
+	}
+
+	rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+
+	bw := bufio.NewWriter(w)
+	for _, line := range lines {
+		bw.WriteString(line)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// randomNames returns n distinct names that don't clash with the devices the
+// puzzle names. They have three letters like the real ones unless there are
+// too many devices for that.
+func randomNames(rng *rand.Rand, n int) []string {
+	seen := map[string]bool{"svr": true, "out": true, "fft": true, "dac": true, "you": true}
+
+	length, combinations := 3, 26*26*26
+	for combinations < 2*(n+len(seen)) {
+		length, combinations = length+1, combinations*26
+	}
+
+	names := make([]string, 0, n)
+	name := make([]byte, length)
+	for len(names) < n {
+		for i := range name {
+			name[i] = byte('a' + rng.IntN(26))
+		}
+		if seen[string(name)] {
+			continue
+		}
+		seen[string(name)] = true
+		names = append(names, string(name))
+	}
+	return names
+}
@@ -1,38 +1,113 @@
-package main
+package day11
 
 import (
-	"flag"
+	"context"
+	"embed"
 	"fmt"
-	"log"
-	"strings"
+	"io"
+	"maps"
+	"slices"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
-func ParseInput(lines []string) map[string][]string {
+//go:embed examples
+var examples embed.FS
+
+var devicePattern = parse.MustCompile("{word}: {word...}", nil)
+
+func ParseInput(lines []string) (map[string][]string, error) {
 	devices := make(map[string][]string, len(lines))
-	for _, line := range lines {
-		parts := strings.Split(line, ":")
-		device := parts[0]
-		outputs := strings.Fields(parts[1])
+	lineOf := make(map[string]int, len(lines))
+	for i, line := range lines {
+		var device string
+		var outputs []string
+		if err := devicePattern.Scan(line, &device, &outputs); err != nil {
+			return nil, aoc.NewParseError(i+1, line, err)
+		}
+
+		if first, exists := lineOf[device]; exists {
+			err := fmt.Errorf("duplicate device %s, first seen at line %d", device, first+1)
+			return nil, &aoc.ParseError{Line: i + 1, Column: 1, Text: line, Err: err}
+		}
+
 		devices[device] = outputs
+		lineOf[device] = i
+	}
+
+	// counting paths through a cycle would never end
+	if device, ok := findCycle(devices); ok {
+		i := lineOf[device]
+		err := fmt.Errorf("device %s feeds back into itself", device)
+		return nil, &aoc.ParseError{Line: i + 1, Column: 1, Text: lines[i], Err: err}
 	}
-	return devices
+
+	return devices, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+// findCycle returns a device on a cycle, if there is one
+func findCycle(devices map[string][]string) (string, bool) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int, len(devices))
+
+	var visit func(device string) (string, bool)
+	visit = func(device string) (string, bool) {
+		switch state[device] {
+		case visiting:
+			return device, true
+		case done:
+			return "", false
+		}
+
+		state[device] = visiting
+		for _, output := range devices[device] {
+			if cycle, ok := visit(output); ok {
+				return cycle, true
+			}
+		}
+		state[device] = done
+		return "", false
+	}
 
-	lines, err := aoc.ReadInputLineByLine(*filename)
+	// visit in a fixed order so the same input always reports the same device
+	for _, device := range slices.Sorted(maps.Keys(devices)) {
+		if cycle, ok := visit(device); ok {
+			return cycle, true
+		}
+	}
+	return "", false
+}
+
+type solver struct {
+	devices map[string][]string
+}
+
+func init() {
+	aoc.Register(11, examples, func() aoc.Solver { return &solver{} })
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := aoc.ReadLines(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	devices := ParseInput(lines)
+	s.devices, err = ParseInput(lines)
+	return err
+}
+
+func (s *solver) PartOne(context.Context) (int, error) {
+	return PartOne(s.devices), nil
+}
 
-	fmt.Println("solution to part one: ", PartOne(devices))
-	fmt.Println("solution to part two: ", PartTwo(devices))
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return PartTwo(s.devices), nil
 }
 
 func PartOne(devices map[string][]string) int {
@@ -0,0 +1,131 @@
+package day12
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/difftest"
+)
+
+type problem struct {
+	shapes [][]string
+	region region
+}
+
+// TestPartOneDifferential checks part one, which settles most regions by
+// their area alone, against packing the presents of every region for real.
+func TestPartOneDifferential(t *testing.T) {
+	difftest.Run(t, difftest.Check[problem]{
+		Generate: smallProblem,
+		MaxSize:  5,
+		Reference: func(p problem) int {
+			ok, _ := fits(context.Background(), p.shapes, p.region)
+			return boolToInt(ok)
+		},
+		Optimised: func(p problem) int {
+			count, _ := PartOne(context.Background(), p.shapes, []region{p.region})
+			return count
+		},
+		Shrink: shrinkProblem,
+		Format: formatProblem,
+	})
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// smallProblem returns a region of up to size+1 tiles either way and a few
+// presents
+func smallProblem(rng *rand.Rand, size int) problem {
+	p := problem{shapes: make([][]string, 1+rng.IntN(3))}
+	for i := range p.shapes {
+		p.shapes[i] = randomShape(rng)
+	}
+
+	w, h := 1+rng.IntN(size+1), 1+rng.IntN(size+1)
+	p.region = region{dim: [2]int{w, h}, quantities: make([]int, len(p.shapes))}
+	for range rng.IntN(w*h/5 + 2) {
+		p.region.quantities[rng.IntN(len(p.shapes))]++
+	}
+	return p
+}
+
+// shrinkProblem yields p without each shape it asks for none of, with one
+// present fewer, with the region one tile narrower or shorter and with each
+// tile of each shape removed
+func shrinkProblem(p problem) iter.Seq[problem] {
+	return func(yield func(problem) bool) {
+		for i, qty := range p.region.quantities {
+			if qty != 0 || len(p.shapes) == 1 {
+				continue
+			}
+			smaller := p
+			smaller.shapes = slices.Delete(slices.Clone(p.shapes), i, i+1)
+			smaller.region.quantities = slices.Delete(slices.Clone(p.region.quantities), i, i+1)
+			if !yield(smaller) {
+				return
+			}
+		}
+
+		for i, qty := range p.region.quantities {
+			if qty == 0 {
+				continue
+			}
+			smaller := p
+			smaller.region.quantities = slices.Clone(p.region.quantities)
+			smaller.region.quantities[i]--
+			if !yield(smaller) {
+				return
+			}
+		}
+
+		for axis := range 2 {
+			if p.region.dim[axis] == 1 {
+				continue
+			}
+			smaller := p
+			smaller.region.dim[axis]--
+			if !yield(smaller) {
+				return
+			}
+		}
+
+		for i, shape := range p.shapes {
+			for y, row := range shape {
+				for x := range row {
+					if row[x] != '#' || strings.Count(strings.Join(shape, ""), "#") == 1 {
+						continue
+					}
+					smaller := p
+					smaller.shapes = slices.Clone(p.shapes)
+					smaller.shapes[i] = slices.Clone(shape)
+					smaller.shapes[i][y] = row[:x] + "." + row[x+1:]
+					if !yield(smaller) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func formatProblem(p problem) string {
+	var sb strings.Builder
+	for i, shape := range p.shapes {
+		fmt.Fprintf(&sb, "%d:\n%s\n\n", i, strings.Join(shape, "\n"))
+	}
+	fmt.Fprintf(&sb, "%dx%d:", p.region.dim[0], p.region.dim[1])
+	for _, qty := range p.region.quantities {
+		fmt.Fprintf(&sb, " %d", qty)
+	}
+	return sb.String()
+}
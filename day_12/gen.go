@@ -0,0 +1,80 @@
+package day12
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(12, generate)
+}
+
+// numShapes is the number of presents in the real inputs
+const numShapes = 6
+
+// generate writes numShapes random presents followed by size regions. Like
+// the real inputs, about half the regions have a 3x3 block to spare for every
+// present and the rest are asked for more tiles than they have, so none of
+// them needs packing for real.
+func generate(w io.Writer, rng *rand.Rand, size int) error {
+	bw := bufio.NewWriter(w)
+
+	shapeTiles := make([]int, numShapes)
+	for i := range numShapes {
+		fmt.Fprintf(bw, "%d:\n", i)
+		for _, row := range randomShape(rng) {
+			shapeTiles[i] += strings.Count(row, "#")
+			bw.WriteString(row)
+			bw.WriteByte('\n')
+		}
+		bw.WriteByte('\n')
+	}
+
+	for range size {
+		width, height := 35+rng.IntN(16), 35+rng.IntN(16)
+		quantities := make([]int, numShapes)
+
+		if blocks := (width / 3) * (height / 3); rng.IntN(2) == 0 {
+			for range blocks - rng.IntN(blocks/4+1) {
+				quantities[rng.IntN(numShapes)]++
+			}
+		} else {
+			// overshoot by a few presents
+			var tiles int
+			for extra := rng.IntN(10); tiles <= width*height || extra > 0; {
+				if tiles > width*height {
+					extra--
+				}
+				shape := rng.IntN(numShapes)
+				quantities[shape]++
+				tiles += shapeTiles[shape]
+			}
+		}
+
+		fmt.Fprintf(bw, "%dx%d:", width, height)
+		for _, qty := range quantities {
+			fmt.Fprintf(bw, " %d", qty)
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// randomShape returns a present of 5 to 7 tiles within 3x3
+func randomShape(rng *rand.Rand) []string {
+	tiles := []byte("#########")
+	// the centre always stays so the shape holds together
+	for _, tile := range rng.Perm(8)[:2+rng.IntN(3)] {
+		if tile >= 4 {
+			tile++
+		}
+		tiles[tile] = '.'
+	}
+
+	return []string{string(tiles[:3]), string(tiles[3:6]), string(tiles[6:])}
+}
@@ -1,20 +1,24 @@
-package main
+package day12
 
 import (
-	"flag"
+	"context"
+	"embed"
+	"errors"
 	"fmt"
-	"log"
-	"regexp"
+	"io"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/ayo-awe/advent-of-code-2025/aoc"
+	"github.com/ayo-awe/advent-of-code-2025/aoc/parse"
 )
 
-var (
-	shapesPattern  = regexp.MustCompile(`\d:\n(?:[#\.]+\n?)+`)
-	regionsPattern = regexp.MustCompile(`\d+x\d+:(?: \d+)+`)
-)
+//go:embed examples
+var examples embed.FS
+
+var regionPattern = parse.MustCompile("{int}x{int}: {int...}", nil)
 
 type region struct {
 	dim        [2]int
@@ -22,39 +26,56 @@ type region struct {
 }
 
 func ParseInput(input string) ([][]string, []region, error) {
-	rawShapes := shapesPattern.FindAllString(input, -1)
-	rawRegions := regionsPattern.FindAllString(input, -1)
+	sections, err := aoc.Sections(input, aoc.AnySections)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	shapes := make([][]string, len(rawShapes))
-	for i, rawShape := range rawShapes {
-		rawShape = strings.Trim(rawShape, "\n")
-		shapes[i] = strings.Split(rawShape, "\n")[1:]
+	if len(sections) < 2 {
+		return nil, nil, fmt.Errorf("found %d sections, expected shapes followed by regions", len(sections))
 	}
 
-	regions := make([]region, len(rawRegions))
-	for i, rawRegion := range rawRegions {
-		parts := strings.Split(rawRegion, ":")
-		rawDim := strings.Split(parts[0], "x")
-		rawQty := strings.Fields(parts[1])
+	// every section but the last is a shape
+	shapeSections, regionSection := sections[:len(sections)-1], sections[len(sections)-1]
 
-		w, err := strconv.Atoi(rawDim[0])
-		if err != nil {
-			return nil, nil, err
+	shapes := make([][]string, len(shapeSections))
+	for i, section := range shapeSections {
+		if section.Name != strconv.Itoa(i) {
+			err := fmt.Errorf("expected header %d: for shape %d", i, i)
+			return nil, nil, &aoc.ParseError{Line: section.Line - 1, Text: section.Name + ":", Err: err}
 		}
 
-		h, err := strconv.Atoi(rawDim[1])
-		if err != nil {
-			return nil, nil, err
+		for j, line := range section.Lines {
+			if col := strings.IndexFunc(line, func(r rune) bool { return r != '#' && r != '.' }); col >= 0 {
+				err := fmt.Errorf("invalid shape tile %q, expected # or .", line[col])
+				return nil, nil, &aoc.ParseError{Line: section.Line + j, Column: col + 1, Text: line, Err: err}
+			}
 		}
 
-		quantities := make([]int, len(rawQty))
-		for i := range rawQty {
-			qty, err := strconv.Atoi(rawQty[i])
-			if err != nil {
-				return nil, nil, err
-			}
+		shapes[i] = section.Lines
+	}
+
+	regions := make([]region, len(regionSection.Lines))
+	for i, rawRegion := range regionSection.Lines {
+		var w, h int
+		var quantities []int
+		if err := regionPattern.Scan(rawRegion, &w, &h, &quantities); err != nil {
+			return nil, nil, aoc.NewParseError(regionSection.Line+i, rawRegion, err)
+		}
+
+		if w < 1 || h < 1 {
+			err := fmt.Errorf("region is %dx%d, expected both sides to be at least 1", w, h)
+			return nil, nil, aoc.NewParseError(regionSection.Line+i, rawRegion, err)
+		}
+
+		if slices.ContainsFunc(quantities, func(qty int) bool { return qty < 0 }) {
+			err := errors.New("negative quantity")
+			return nil, nil, aoc.NewParseError(regionSection.Line+i, rawRegion, err)
+		}
 
-			quantities[i] = qty
+		if len(quantities) != len(shapes) {
+			err := fmt.Errorf("found %d quantities, expected one per shape (%d)", len(quantities), len(shapes))
+			return nil, nil, aoc.NewParseError(regionSection.Line+i, rawRegion, err)
 		}
 
 		regions[i] = region{dim: [2]int{w, h}, quantities: quantities}
@@ -63,38 +84,97 @@ func ParseInput(input string) ([][]string, []region, error) {
 	return shapes, regions, nil
 }
 
-func main() {
-	filename := flag.String("file", "input.txt", "input file name")
-	flag.Parse()
+type solver struct {
+	shapes  [][]string
+	regions []region
+}
 
-	input, err := aoc.ReadInput(*filename)
-	if err != nil {
-		log.Fatal(err)
-	}
+func init() {
+	aoc.Register(12, examples, func() aoc.Solver { return &solver{} })
+}
 
-	shapes, regions, err := ParseInput(input)
+func (s *solver) Parse(r io.Reader) error {
+	input, err := aoc.ReadString(r)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	fmt.Println("solution to part one: ", PartOne(shapes, regions))
+	s.shapes, s.regions, err = ParseInput(input)
+	return err
 }
 
-func PartOne(shapes [][]string, regions []region) int {
+func (s *solver) PartOne(ctx context.Context) (int, error) {
+	return PartOne(ctx, s.shapes, s.regions)
+}
+
+func (s *solver) PartTwo(context.Context) (int, error) {
+	return 0, aoc.ErrNoPart
+}
+
+func PartOne(ctx context.Context, shapes [][]string, regions []region) (int, error) {
+	shapeTiles := make([]int, len(shapes))
+	shapeSides := make([]int, len(shapes))
+	for i, shape := range shapes {
+		shapeTiles[i] = strings.Count(strings.Join(shape, ""), "#")
+		shapeSides[i] = boxSide(shape)
+	}
+
 	var count int
 	for _, region := range regions {
-		area := region.dim[0] * region.dim[1]
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 
-		totalQty := 0
-		for _, qty := range region.quantities {
-			totalQty += qty
+		w, h := region.dim[0], region.dim[1]
+
+		// side is that of the smallest square block any of the region's
+		// presents fits in, in any orientation
+		presents, tiles, side := 0, 0, 1
+		for i, qty := range region.quantities {
+			presents += qty
+			tiles += qty * shapeTiles[i]
+			if qty > 0 {
+				side = max(side, shapeSides[i])
+			}
 		}
 
-		reqArea := totalQty * 9
-		if area >= reqArea {
+		switch {
+		case (w/side)*(h/side) >= presents:
+			// every present gets a block of its own, which settles the
+			// regions of the real inputs that fit
 			count++
+		case tiles > w*h:
+			// the presents cover more tiles than there are, which settles
+			// the rest of them
+		default:
+			// anything in between, such as the example's regions, has to be
+			// packed for real
+			ok, err := fits(ctx, shapes, region)
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				count++
+			}
 		}
 	}
-	return count
+	return count, nil
 }
 
+// boxSide returns the longer side of the bounding box of a shape's tiles
+func boxSide(shape []string) int {
+	minX, minY, maxX, maxY := math.MaxInt, math.MaxInt, -1, -1
+	for y, row := range shape {
+		for x, tile := range row {
+			if tile == '#' {
+				minX, minY = min(minX, x), min(minY, y)
+				maxX, maxY = max(maxX, x), max(maxY, y)
+			}
+		}
+	}
+
+	if maxX < 0 {
+		return 0
+	}
+	return max(maxX-minX, maxY-minY) + 1
+}
@@ -0,0 +1,19 @@
+package day12
+
+import (
+	"testing"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 12)
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.Generate(t, 12)
+}
+
+func FuzzParseInput(f *testing.F) {
+	aoctest.Fuzz(f, 12)
+}
@@ -0,0 +1,149 @@
+package day12
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ayo-awe/advent-of-code-2025/aoc"
+)
+
+// cancelCheckInterval is how many placements the search tries between
+// looking for cancellation
+const cancelCheckInterval = 1 << 12
+
+// fits reports whether every present of r can be placed in it, in any
+// rotation or reflection, without overlapping another. The search can take
+// very long for tight regions and gives up with ctx.Err() once ctx is done.
+func fits(ctx context.Context, shapes [][]string, r region) (bool, error) {
+	w, h := r.dim[0], r.dim[1]
+
+	// every distinct orientation of each shape as offsets from its first
+	// tile in reading order, which is where it will be anchored
+	orientations := make([][][]aoc.Point, len(shapes))
+	for i, shape := range shapes {
+		orientations[i] = shapeOrientations(shape)
+	}
+
+	remaining := slices.Clone(r.quantities)
+	var needed int
+	for i, qty := range remaining {
+		needed += qty * len(orientations[i][0])
+	}
+
+	filled := make([]bool, w*h)
+
+	var visited int
+	var err error
+
+	// search fills the region in reading order, deciding for the tile at pos
+	// whether a present is anchored there or it stays empty. free counts the
+	// tiles from pos on that are still empty.
+	var search func(pos, free int) bool
+	search = func(pos, free int) bool {
+		if visited++; visited%cancelCheckInterval == 0 && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return false
+		}
+
+		if needed == 0 {
+			return true
+		}
+		for pos < w*h && filled[pos] {
+			pos++
+		}
+		if needed > free || pos == w*h {
+			return false
+		}
+
+		anchor := aoc.Point{X: pos % w, Y: pos / w}
+		for shape, orients := range orientations {
+			if remaining[shape] == 0 {
+				continue
+			}
+
+			for _, tiles := range orients {
+				if !place(filled, w, h, anchor, tiles, true) {
+					continue
+				}
+
+				remaining[shape]--
+				needed -= len(tiles)
+				ok := search(pos+1, free-len(tiles))
+				remaining[shape]++
+				needed += len(tiles)
+				place(filled, w, h, anchor, tiles, false)
+
+				if ok {
+					return true
+				}
+			}
+		}
+
+		return search(pos+1, free-1)
+	}
+
+	ok := search(0, w*h)
+	return ok, err
+}
+
+// place sets the tiles of a present anchored at anchor to state, if they are
+// all within the region and, when placing it, all empty
+func place(filled []bool, w, h int, anchor aoc.Point, tiles []aoc.Point, state bool) bool {
+	for _, tile := range tiles {
+		p := anchor.Add(tile)
+		if p.X < 0 || p.Y < 0 || p.X >= w || p.Y >= h || (state && filled[p.Y*w+p.X]) {
+			return false
+		}
+	}
+	for _, tile := range tiles {
+		p := anchor.Add(tile)
+		filled[p.Y*w+p.X] = state
+	}
+	return true
+}
+
+// shapeOrientations returns the distinct rotations and reflections of a shape
+// as tiles relative to their first tile in reading order
+func shapeOrientations(shape []string) [][]aoc.Point {
+	var tiles []aoc.Point
+	for y, row := range shape {
+		for x, tile := range row {
+			if tile == '#' {
+				tiles = append(tiles, aoc.Point{X: x, Y: y})
+			}
+		}
+	}
+
+	var orientations [][]aoc.Point
+	for flip := range 2 {
+		for rotation := range 4 {
+			oriented := make([]aoc.Point, len(tiles))
+			for i, t := range tiles {
+				if flip == 1 {
+					t.X = -t.X
+				}
+				for range rotation {
+					t = aoc.Point{X: -t.Y, Y: t.X}
+				}
+				oriented[i] = t
+			}
+
+			slices.SortFunc(oriented, func(a, b aoc.Point) int {
+				if a.Y != b.Y {
+					return a.Y - b.Y
+				}
+				return a.X - b.X
+			})
+			for i := len(oriented) - 1; i >= 0; i-- {
+				oriented[i] = aoc.Point{X: oriented[i].X - oriented[0].X, Y: oriented[i].Y - oriented[0].Y}
+			}
+
+			if !slices.ContainsFunc(orientations, func(o []aoc.Point) bool { return slices.Equal(o, oriented) }) {
+				orientations = append(orientations, oriented)
+			}
+		}
+	}
+	return orientations
+}
@@ -4,6 +4,8 @@
 
 # Usage:
 # Set $AOC_SESSION to AOC session cookie: export AOC_SESSION=<cookie> or via a .env file
+# Days are scaffolded with `go run ./cmd/aoc new <day> [year]` and inputs are downloaded
+# with `go run ./cmd/aoc fetch <day> [year]`
 # Then: source setup.sh <day#>
 #  e.g. source setup.sh 3
 # Optionally, include the year for completing previous year's challenges:
@@ -11,28 +13,6 @@
 #  e.g. source setup.sh 3 2022
 
 day=$1
-main_file_template=$(echo -n "package main
-
-import (
-    \"flag\"
-    \"fmt\"
-)
-
-func main() {
-	filename := flag.String(\"file\", \"input.txt\", \"input file name\")
-	flag.Parse()
-
-    fmt.Println(\"solution to part one: \", PartOne())
-    fmt.Println(\"solution to part two: \", PartTwo())
-}
-
-func PartOne() int {
-    return 0
-}
-
-func PartTwo() int {
-    return 0
-}")
 
 if [ -z "$day" ]; then
     echo "Usage: $0 <day> [year]"
@@ -45,32 +25,19 @@ if [[ ! "$day" =~ ^[0-9]+$ ]]; then
 fi
 
 folder=$(printf "day_%02d" "$day")
-main_file="$folder/main.go"
-input_file="$folder/input.txt"
-
-if [ ! -e "$folder" ]; then
-    mkdir "$folder"
-fi
-
-
-if [ ! -e "$main_file" ]; then
-    echo -n "$main_file_template" > "$main_file"
-fi
 
-# load .env if it exists
-if [ -e ".env" ]; then
-    set -a
-    source .env
-    set +a
+# days of other years than 2025 live under a directory named after the year
+if [ -n "$2" ] && [ "$2" != "2025" ]; then
+    folder="$2/$folder"
 fi
 
-# fetch input for current day
-if [ -z "$AOC_SESSION" ]; then
-  echo "\$AOC_SESSION isn't set. Can't fetch your input. Quitting"
-  return
+if [ ! -e "$folder/main.go" ]; then
+    go run ./cmd/aoc new "$day" ${2:+"$2"}
 fi
 
-year=${2:-$(date +%Y)}
-url_base="https://adventofcode.com/${year}/day/${day}"
+# fetch input for current day, aoc fetch reads $AOC_SESSION from the
+# environment or .env itself
+go run ./cmd/aoc fetch "$day" ${2:+"$2"}
 
-curl -s "${url_base}/input" --cookie "session=${AOC_SESSION}" -o $input_file
+# download the puzzle description and its first example
+go run ./cmd/aoc puzzle "$day" ${2:+"$2"}